@@ -6,6 +6,7 @@ import (
 	"image/color"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -236,7 +237,7 @@ func (c *albumCell) CreateRenderer() fyne.WidgetRenderer {
 	title.TextSize = c.view.TitleFontSize
 	title.TextStyle = fyne.TextStyle{Bold: true}
 
-	count := canvas.NewText("", c.view.CountColor)
+	count := canvas.NewText(photoCountText(c.album.PhotoCount), c.view.CountColor)
 	count.TextSize = c.view.CountFontSize
 
 	separator := canvas.NewRectangle(c.view.SeparatorColor)
@@ -339,14 +340,7 @@ func (r *albumCellRenderer) Refresh() {
 	r.title.Text = r.cell.album.Name
 	r.title.Color = r.cell.view.TitleColor
 
-	if r.cell.album.PhotoCount > 0 {
-		r.count.Text = string(rune('0'+r.cell.album.PhotoCount%10)) + " photos"
-		if r.cell.album.PhotoCount >= 10 {
-			r.count.Text = string(rune('0'+r.cell.album.PhotoCount/10)) + string(rune('0'+r.cell.album.PhotoCount%10)) + " photos"
-		}
-	} else {
-		r.count.Text = "Empty"
-	}
+	r.count.Text = photoCountText(r.cell.album.PhotoCount)
 
 	r.bg.Refresh()
 	r.thumbnail.Refresh()
@@ -356,6 +350,18 @@ func (r *albumCellRenderer) Refresh() {
 	r.disclosure.Refresh()
 }
 
+// photoCountText formats an album photo count for display
+func photoCountText(count int) string {
+	switch {
+	case count <= 0:
+		return "Empty"
+	case count == 1:
+		return "1 photo"
+	default:
+		return strconv.Itoa(count) + " photos"
+	}
+}
+
 func (r *albumCellRenderer) Objects() []fyne.CanvasObject {
 	return []fyne.CanvasObject{r.bg, r.thumbnail, r.title, r.count, r.separator, r.disclosure}
 }
@@ -378,12 +384,20 @@ type PhotoGridView struct {
 	CellBackgroundColor     color.Color
 	CellSelectedBorderColor color.Color
 	CellSelectedBorderWidth float32
+	SelectionBadgeColor     color.Color
+	SelectionBadgeTextColor color.Color
+	SelectionBadgeSize      float32
+
+	// Selection
+	AllowsMultipleSelection bool
 
 	// Callbacks
-	OnPhotoSelected func(photo *Photo)
+	OnPhotoSelected    func(photo *Photo)
+	OnSelectionChanged func(photos []*Photo)
 
 	// State
-	mu sync.RWMutex
+	mu       sync.RWMutex
+	selected []*Photo
 }
 
 // NewPhotoGridView creates a new photo grid view
@@ -398,6 +412,9 @@ func NewPhotoGridView() *PhotoGridView {
 		CellBackgroundColor:     color.RGBA{R: 240, G: 240, B: 240, A: 255},
 		CellSelectedBorderColor: config.BlueColor,
 		CellSelectedBorderWidth: 3,
+		SelectionBadgeColor:     config.BlueColor,
+		SelectionBadgeTextColor: color.White,
+		SelectionBadgeSize:      22,
 	}
 	pgv.ExtendBaseWidget(pgv)
 	return pgv
@@ -418,6 +435,76 @@ func (pgv *PhotoGridView) SetPhotos(photos []*Photo) {
 	pgv.Refresh()
 }
 
+// SelectedPhotos returns the selected photos in selection order
+func (pgv *PhotoGridView) SelectedPhotos() []*Photo {
+	pgv.mu.RLock()
+	defer pgv.mu.RUnlock()
+	photos := make([]*Photo, len(pgv.selected))
+	copy(photos, pgv.selected)
+	return photos
+}
+
+// IsPhotoSelected returns whether the photo is selected
+func (pgv *PhotoGridView) IsPhotoSelected(photo *Photo) bool {
+	return pgv.selectionNumber(photo) > 0
+}
+
+// SetPhotoSelected selects or deselects a photo
+func (pgv *PhotoGridView) SetPhotoSelected(photo *Photo, selected bool) {
+	pgv.mu.Lock()
+	index := -1
+	for i, p := range pgv.selected {
+		if p == photo {
+			index = i
+			break
+		}
+	}
+	changed := false
+	if selected && index < 0 {
+		pgv.selected = append(pgv.selected, photo)
+		changed = true
+	} else if !selected && index >= 0 {
+		pgv.selected = append(pgv.selected[:index], pgv.selected[index+1:]...)
+		changed = true
+	}
+	pgv.mu.Unlock()
+
+	if changed {
+		pgv.selectionChanged()
+	}
+}
+
+// ClearSelection deselects all photos
+func (pgv *PhotoGridView) ClearSelection() {
+	pgv.mu.Lock()
+	changed := len(pgv.selected) > 0
+	pgv.selected = nil
+	pgv.mu.Unlock()
+
+	if changed {
+		pgv.selectionChanged()
+	}
+}
+
+// selectionNumber returns the 1-based selection order of a photo, or 0
+func (pgv *PhotoGridView) selectionNumber(photo *Photo) int {
+	pgv.mu.RLock()
+	defer pgv.mu.RUnlock()
+	for i, p := range pgv.selected {
+		if p == photo {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (pgv *PhotoGridView) selectionChanged() {
+	pgv.Refresh()
+	if pgv.OnSelectionChanged != nil {
+		pgv.OnSelectionChanged(pgv.SelectedPhotos())
+	}
+}
+
 // CreateRenderer implements fyne.Widget
 func (pgv *PhotoGridView) CreateRenderer() fyne.WidgetRenderer {
 	pgv.ExtendBaseWidget(pgv)
@@ -522,13 +609,38 @@ func (c *photoCell) CreateRenderer() fyne.WidgetRenderer {
 
 	bg := canvas.NewRectangle(c.view.CellBackgroundColor)
 
-	return &photoCellRenderer{
-		cell: c,
-		bg:   bg,
+	border := canvas.NewRectangle(color.Transparent)
+	border.StrokeColor = c.view.CellSelectedBorderColor
+	border.StrokeWidth = c.view.CellSelectedBorderWidth
+	border.Hide()
+
+	badge := canvas.NewCircle(c.view.SelectionBadgeColor)
+	badge.StrokeColor = color.White
+	badge.StrokeWidth = 1.5
+	badge.Hide()
+
+	badgeText := canvas.NewText("", c.view.SelectionBadgeTextColor)
+	badgeText.TextSize = c.view.SelectionBadgeSize * 0.55
+	badgeText.TextStyle = fyne.TextStyle{Bold: true}
+	badgeText.Alignment = fyne.TextAlignCenter
+	badgeText.Hide()
+
+	r := &photoCellRenderer{
+		cell:      c,
+		bg:        bg,
+		border:    border,
+		badge:     badge,
+		badgeText: badgeText,
 	}
+	r.Refresh()
+	return r
 }
 
 func (c *photoCell) Tapped(*fyne.PointEvent) {
+	if c.view.AllowsMultipleSelection {
+		c.view.SetPhotoSelected(c.photo, !c.view.IsPhotoSelected(c.photo))
+		return
+	}
 	if c.view.OnPhotoSelected != nil {
 		c.view.OnPhotoSelected(c.photo)
 	}
@@ -557,9 +669,12 @@ func (c *photoCell) Cursor() desktop.Cursor {
 }
 
 type photoCellRenderer struct {
-	cell  *photoCell
-	bg    *canvas.Rectangle
-	image *canvas.Image
+	cell      *photoCell
+	bg        *canvas.Rectangle
+	image     *canvas.Image
+	border    *canvas.Rectangle
+	badge     *canvas.Circle
+	badgeText *canvas.Text
 }
 
 func (r *photoCellRenderer) Destroy() {}
@@ -569,6 +684,15 @@ func (r *photoCellRenderer) Layout(size fyne.Size) {
 	if r.image != nil {
 		r.image.Resize(size)
 	}
+	r.border.Resize(size)
+
+	badgeSize := r.cell.view.SelectionBadgeSize
+	badgePos := fyne.NewPos(size.Width-badgeSize-4, 4)
+	r.badge.Resize(fyne.NewSize(badgeSize, badgeSize))
+	r.badge.Move(badgePos)
+	textHeight := r.badgeText.MinSize().Height
+	r.badgeText.Resize(fyne.NewSize(badgeSize, textHeight))
+	r.badgeText.Move(fyne.NewPos(badgePos.X, badgePos.Y+(badgeSize-textHeight)/2))
 }
 
 func (r *photoCellRenderer) MinSize() fyne.Size {
@@ -586,13 +710,32 @@ func (r *photoCellRenderer) Refresh() {
 		r.bg.FillColor = r.cell.view.CellBackgroundColor
 	}
 	r.bg.Refresh()
+
+	number := r.cell.view.selectionNumber(r.cell.photo)
+	if number > 0 {
+		r.border.StrokeColor = r.cell.view.CellSelectedBorderColor
+		r.border.StrokeWidth = r.cell.view.CellSelectedBorderWidth
+		r.badge.FillColor = r.cell.view.SelectionBadgeColor
+		r.badgeText.Text = strconv.Itoa(number)
+		r.badgeText.Color = r.cell.view.SelectionBadgeTextColor
+		r.border.Show()
+		r.badge.Show()
+		r.badgeText.Show()
+	} else {
+		r.border.Hide()
+		r.badge.Hide()
+		r.badgeText.Hide()
+	}
+	r.border.Refresh()
+	r.badge.Refresh()
+	r.badgeText.Refresh()
 }
 
 func (r *photoCellRenderer) Objects() []fyne.CanvasObject {
 	if r.image != nil {
-		return []fyne.CanvasObject{r.bg, r.image}
+		return []fyne.CanvasObject{r.bg, r.image, r.border, r.badge, r.badgeText}
 	}
-	return []fyne.CanvasObject{r.bg}
+	return []fyne.CanvasObject{r.bg, r.border, r.badge, r.badgeText}
 }
 
 // AlbumViewController is a full album browser view controller
@@ -608,6 +751,7 @@ type AlbumViewController struct {
 
 	// Callbacks
 	OnPhotoSelected func(photo *Photo)
+	OnSelectionDone func(photos []*Photo)
 	OnCancel        func()
 
 	// State
@@ -684,6 +828,14 @@ func (avc *AlbumViewController) Dismiss() {
 	avc.mu.Unlock()
 }
 
+// Done returns the selected photos via OnSelectionDone and dismisses
+func (avc *AlbumViewController) Done() {
+	if avc.OnSelectionDone != nil {
+		avc.OnSelectionDone(avc.PhotoGrid.SelectedPhotos())
+	}
+	avc.Dismiss()
+}
+
 func (avc *AlbumViewController) buildContent() fyne.CanvasObject {
 	config := core.SharedConfiguration()
 
@@ -714,11 +866,21 @@ func (avc *AlbumViewController) buildContent() fyne.CanvasObject {
 	}
 	title.Alignment = fyne.TextAlignCenter
 
+	// Done returns the multiple selection
+	var trailing fyne.CanvasObject = cancelBtn
+	if avc.PhotoGrid.AllowsMultipleSelection {
+		doneBtn := widget.NewButton("Done", func() {
+			avc.Done()
+		})
+		doneBtn.Importance = widget.HighImportance
+		trailing = container.NewHBox(cancelBtn, doneBtn)
+	}
+
 	var toolbar fyne.CanvasObject
 	if backBtn != nil {
-		toolbar = container.NewBorder(nil, nil, backBtn, cancelBtn, title)
+		toolbar = container.NewBorder(nil, nil, backBtn, trailing, title)
 	} else {
-		toolbar = container.NewBorder(nil, nil, nil, cancelBtn, title)
+		toolbar = container.NewBorder(nil, nil, nil, trailing, title)
 	}
 
 	toolbarWithBg := container.NewStack(bg, toolbar)
@@ -10,7 +10,7 @@ import (
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/layout"
-	
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
@@ -45,10 +45,20 @@ type Action struct {
 	Enabled bool
 	Handler func(controller *Alert, action *Action)
 
+	// KeepsAlertOpen keeps the alert showing after Handler runs, with a
+	// loading indicator on the button, until the app calls Hide. Call
+	// SetLoading(false) to let the user tap the button again instead, as
+	// when the work failed.
+	KeepsAlertOpen bool
+
 	// Custom attributes
 	TextColor         color.Color
 	DisabledTextColor color.Color
 	BackgroundColor   color.Color
+
+	mu      sync.RWMutex
+	loading bool
+	button  *actionButton // Shows the action in the alert currently built
 }
 
 // NewAction creates a new alert action
@@ -61,6 +71,26 @@ func NewAction(title string, style ActionStyle, handler func(controller *Alert,
 	}
 }
 
+// SetLoading shows or hides the loading indicator in place of the action's
+// title. The button ignores taps while loading.
+func (a *Action) SetLoading(loading bool) {
+	a.mu.Lock()
+	a.loading = loading
+	button := a.button
+	a.mu.Unlock()
+
+	if button != nil {
+		button.Refresh()
+	}
+}
+
+// IsLoading returns whether the action shows its loading indicator
+func (a *Action) IsLoading() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.loading
+}
+
 // Delegate provides callbacks for alert controller events
 type Delegate interface {
 	WillShow(controller *Alert)
@@ -85,15 +115,23 @@ type Alert struct {
 	// Text fields (for alert style)
 	textFields []*widget.Entry
 
-	// Custom view
-	customView fyne.CanvasObject
+	// Custom view. CustomViewMaxHeight, when set, caps its height and
+	// scrolls the view within it.
+	customView          fyne.CanvasObject
+	CustomViewMaxHeight float32
+
+	// Image header drawn full width above the title
+	headerImage fyne.Resource
 
 	// Delegate
 	Delegate Delegate
 
-	// Alert styling
+	// Alert styling. The alert is AlertContentMaximumWidth wide, growing to
+	// AlertContentWidthFraction of a wide canvas up to AlertContentWidthLimit.
 	AlertContentMargin            core.EdgeInsets
 	AlertContentMaximumWidth      float32
+	AlertContentWidthFraction     float32
+	AlertContentWidthLimit        float32
 	AlertSeparatorColor           color.Color
 	AlertTitleColor               color.Color
 	AlertTitleFontSize            float32
@@ -111,9 +149,11 @@ type Alert struct {
 	AlertHeaderInsets             core.EdgeInsets
 	AlertTitleMessageSpacing      float32
 
-	// Sheet styling
+	// Sheet styling, sized like an alert by the Sheet width fields
 	SheetContentMargin            core.EdgeInsets
 	SheetContentMaximumWidth      float32
+	SheetContentWidthFraction     float32
+	SheetContentWidthLimit        float32
 	SheetSeparatorColor           color.Color
 	SheetTitleColor               color.Color
 	SheetTitleFontSize            float32
@@ -137,12 +177,17 @@ type Alert struct {
 	OrderActionsByAddedOrdered   bool
 	ShouldRespondDimmingViewTouch bool
 	IsExtendBottomLayout         bool
+	KeyboardAvoidance            bool // Move an action sheet up while a field in it has focus
 
 	// State
-	mu       sync.RWMutex
-	visible  bool
-	window   fyne.Window
-	overlay  *widget.PopUp
+	mu            sync.RWMutex
+	visible       bool
+	window        fyne.Window
+	overlay       *widget.PopUp
+	fittedSize    fyne.Size
+	headerLayout  *imageHeaderLayout
+	keyboard      *core.KeyboardAvoider
+	colorDefaults core.ColorDefaults
 }
 
 // NewAlert creates a new alert controller
@@ -158,41 +203,25 @@ func NewAlert(title, message string, style ControllerStyle) *Alert {
 		// Alert defaults
 		AlertContentMargin:            config.AlertContentMargin,
 		AlertContentMaximumWidth:      config.AlertContentMaximumWidth,
-		AlertSeparatorColor:           config.AlertSeparatorColor,
-		AlertTitleColor:               color.Black,
+		AlertContentWidthFraction:     config.AlertContentWidthFraction,
+		AlertContentWidthLimit:        config.AlertContentWidthLimit,
 		AlertTitleFontSize:            17,
-		AlertMessageColor:             color.Black,
 		AlertMessageFontSize:          13,
-		AlertButtonTextColor:          config.BlueColor,
-		AlertButtonDisabledTextColor:  config.GrayColor,
-		AlertCancelButtonTextColor:    config.BlueColor,
-		AlertDestructiveButtonTextColor: config.RedColor,
 		AlertContentCornerRadius:      config.AlertContentCornerRadius,
 		AlertButtonHeight:             config.AlertButtonHeight,
-		AlertHeaderBackgroundColor:    config.AlertHeaderBackgroundColor,
-		AlertButtonBackgroundColor:    config.AlertButtonBackgroundColor,
-		AlertButtonHighlightBackgroundColor: config.AlertButtonHighlightBackgroundColor,
 		AlertHeaderInsets:             config.AlertHeaderInsets,
 		AlertTitleMessageSpacing:      config.AlertTitleMessageSpacing,
 
 		// Sheet defaults
 		SheetContentMargin:            config.SheetContentMargin,
 		SheetContentMaximumWidth:      config.SheetContentMaximumWidth,
-		SheetSeparatorColor:           config.SheetSeparatorColor,
-		SheetTitleColor:               config.GrayColor,
+		SheetContentWidthFraction:     config.SheetContentWidthFraction,
+		SheetContentWidthLimit:        config.SheetContentWidthLimit,
 		SheetTitleFontSize:            13,
-		SheetMessageColor:             config.GrayColor,
 		SheetMessageFontSize:          13,
-		SheetButtonTextColor:          config.BlueColor,
-		SheetButtonDisabledTextColor:  config.GrayColor,
-		SheetCancelButtonTextColor:    config.BlueColor,
-		SheetDestructiveButtonTextColor: config.RedColor,
 		SheetCancelButtonMarginTop:    config.SheetCancelButtonMarginTop,
 		SheetContentCornerRadius:      config.SheetContentCornerRadius,
 		SheetButtonHeight:             config.SheetButtonHeight,
-		SheetHeaderBackgroundColor:    config.SheetHeaderBackgroundColor,
-		SheetButtonBackgroundColor:    config.SheetButtonBackgroundColor,
-		SheetButtonHighlightBackgroundColor: config.SheetButtonHighlightBackgroundColor,
 		SheetHeaderInsets:             config.SheetHeaderInsets,
 		SheetTitleMessageSpacing:      config.SheetTitleMessageSpacing,
 		SheetButtonColumnCount:        config.SheetButtonColumnCount,
@@ -200,11 +229,41 @@ func NewAlert(title, message string, style ControllerStyle) *Alert {
 		OrderActionsByAddedOrdered:   false,
 		ShouldRespondDimmingViewTouch: style == ControllerStyleActionSheet,
 		IsExtendBottomLayout:         false,
+		KeyboardAvoidance:            style == ControllerStyleActionSheet,
 	}
+	ac.applyColorDefaults()
 	ac.ExtendBaseWidget(ac)
 	return ac
 }
 
+// applyColorDefaults sets the colors that weren't customized from the
+// configuration, so an alert shown after a theme switch uses the new theme
+func (ac *Alert) applyColorDefaults() {
+	config := core.SharedConfiguration()
+	ac.colorDefaults.Apply(
+		core.ColorDefault{Field: &ac.AlertSeparatorColor, Value: config.AlertSeparatorColor},
+		core.ColorDefault{Field: &ac.AlertTitleColor, Value: config.AlertTitleColor},
+		core.ColorDefault{Field: &ac.AlertMessageColor, Value: config.AlertMessageColor},
+		core.ColorDefault{Field: &ac.AlertButtonTextColor, Value: config.BlueColor},
+		core.ColorDefault{Field: &ac.AlertButtonDisabledTextColor, Value: config.GrayColor},
+		core.ColorDefault{Field: &ac.AlertCancelButtonTextColor, Value: config.BlueColor},
+		core.ColorDefault{Field: &ac.AlertDestructiveButtonTextColor, Value: config.RedColor},
+		core.ColorDefault{Field: &ac.AlertHeaderBackgroundColor, Value: config.AlertHeaderBackgroundColor},
+		core.ColorDefault{Field: &ac.AlertButtonBackgroundColor, Value: config.AlertButtonBackgroundColor},
+		core.ColorDefault{Field: &ac.AlertButtonHighlightBackgroundColor, Value: config.AlertButtonHighlightBackgroundColor},
+		core.ColorDefault{Field: &ac.SheetSeparatorColor, Value: config.SheetSeparatorColor},
+		core.ColorDefault{Field: &ac.SheetTitleColor, Value: config.GrayColor},
+		core.ColorDefault{Field: &ac.SheetMessageColor, Value: config.GrayColor},
+		core.ColorDefault{Field: &ac.SheetButtonTextColor, Value: config.BlueColor},
+		core.ColorDefault{Field: &ac.SheetButtonDisabledTextColor, Value: config.GrayColor},
+		core.ColorDefault{Field: &ac.SheetCancelButtonTextColor, Value: config.BlueColor},
+		core.ColorDefault{Field: &ac.SheetDestructiveButtonTextColor, Value: config.RedColor},
+		core.ColorDefault{Field: &ac.SheetHeaderBackgroundColor, Value: config.SheetHeaderBackgroundColor},
+		core.ColorDefault{Field: &ac.SheetButtonBackgroundColor, Value: config.SheetButtonBackgroundColor},
+		core.ColorDefault{Field: &ac.SheetButtonHighlightBackgroundColor, Value: config.SheetButtonHighlightBackgroundColor},
+	)
+}
+
 // AddAction adds an action button
 func (ac *Alert) AddAction(action *Action) {
 	ac.mu.Lock()
@@ -228,13 +287,96 @@ func (ac *Alert) AddTextField(configHandler func(entry *widget.Entry)) {
 	ac.mu.Unlock()
 }
 
-// AddCustomView adds a custom view to the alert
+// AddCustomView adds a custom view to the alert, shown below the message in
+// both styles. ShowSingleSelect lists its options in an action sheet this way.
 func (ac *Alert) AddCustomView(view fyne.CanvasObject) {
 	ac.mu.Lock()
 	ac.customView = view
 	ac.mu.Unlock()
 }
 
+// AddImageHeader adds an image, such as an illustration, drawn full width
+// above the title
+func (ac *Alert) AddImageHeader(res fyne.Resource) {
+	ac.mu.Lock()
+	ac.headerImage = res
+	ac.mu.Unlock()
+}
+
+// withImageHeader returns body on its background, below the header image
+// when the alert has one. The image runs under the body by the corner
+// radius and the background is squared off at the top to cover it, so only
+// the image's top corners are rounded. width is the expected content width,
+// which sizes the image to its aspect ratio.
+func (ac *Alert) withImageHeader(body fyne.CanvasObject, background *canvas.Rectangle, width float32) fyne.CanvasObject {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	ac.headerLayout = nil
+	if ac.headerImage == nil {
+		return container.NewStack(background, body)
+	}
+
+	radius := background.CornerRadius
+	image := canvas.NewImageFromResource(ac.headerImage)
+	image.FillMode = canvas.ImageFillCover
+	image.CornerRadius = radius
+	background.CornerRadius = 0
+	background.BottomLeftCornerRadius = radius
+	background.BottomRightCornerRadius = radius
+
+	ac.headerLayout = &imageHeaderLayout{image: image, radius: radius, width: width}
+	return container.New(ac.headerLayout, image, container.NewStack(background, body))
+}
+
+// imageHeaderLayout lays out the header image above the body, extending the
+// image under the body by radius
+type imageHeaderLayout struct {
+	image  *canvas.Image
+	radius float32
+	width  float32 // The content width the image height is taken from
+}
+
+// imageHeight returns the image height for the content width
+func (l *imageHeaderLayout) imageHeight() float32 {
+	if aspect := l.image.Aspect(); aspect > 0 {
+		return l.width / aspect
+	}
+	return alertImageHeaderHeight
+}
+
+func (l *imageHeaderLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	body := objects[1].MinSize()
+	return fyne.NewSize(body.Width, l.imageHeight()+body.Height)
+}
+
+func (l *imageHeaderLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	imageHeight := fyne.Max(0, size.Height-objects[1].MinSize().Height)
+	objects[0].Resize(fyne.NewSize(size.Width, imageHeight+l.radius))
+	objects[0].Move(fyne.NewPos(0, 0))
+	objects[1].Resize(fyne.NewSize(size.Width, size.Height-imageHeight))
+	objects[1].Move(fyne.NewPos(0, imageHeight))
+}
+
+// alertImageHeaderHeight is the header image height when the image's aspect
+// ratio is unknown
+const alertImageHeaderHeight float32 = 120
+
+// customViewObject returns the custom view, scrolling it when it is taller
+// than CustomViewMaxHeight
+func (ac *Alert) customViewObject() fyne.CanvasObject {
+	ac.mu.RLock()
+	view := ac.customView
+	maxHeight := ac.CustomViewMaxHeight
+	ac.mu.RUnlock()
+
+	if view == nil || maxHeight <= 0 || view.MinSize().Height <= maxHeight {
+		return view
+	}
+	scroll := container.NewVScroll(view)
+	scroll.SetMinSize(fyne.NewSize(view.MinSize().Width, maxHeight))
+	return scroll
+}
+
 // GetActions returns all actions
 func (ac *Alert) GetActions() []*Action {
 	ac.mu.RLock()
@@ -260,23 +402,29 @@ func (ac *Alert) ShowIn(window fyne.Window) {
 		ac.Delegate.WillShow(ac)
 	}
 
+	ac.applyColorDefaults()
 	content := ac.buildContent()
 
 	// For ActionSheet style with ShouldRespondDimmingViewTouch, use PopUp so
 	// tapping outside dismisses it
+	var overlay *widget.PopUp
 	if ac.Style == ControllerStyleActionSheet && ac.ShouldRespondDimmingViewTouch {
-		ac.overlay = widget.NewPopUp(content, window.Canvas())
-		// Position at bottom of screen
-		canvasSize := window.Canvas().Size()
-		contentSize := content.MinSize()
-		ac.overlay.Move(fyne.NewPos(
-			(canvasSize.Width-contentSize.Width)/2,
-			canvasSize.Height-contentSize.Height-20,
-		))
-		ac.overlay.Show()
+		overlay = widget.NewPopUp(newAlertFrame(ac, content), window.Canvas())
 	} else {
-		ac.overlay = widget.NewModalPopUp(content, window.Canvas())
-		ac.overlay.Show()
+		overlay = widget.NewModalPopUp(newAlertFrame(ac, content), window.Canvas())
+	}
+	ac.mu.Lock()
+	ac.overlay = overlay
+	ac.fittedSize = fyne.Size{}
+	ac.mu.Unlock()
+	ac.fitToCanvas()
+
+	core.SharedOverlayManager().Show(window.Canvas(), ac.overlay, core.SharedConfiguration().WindowLevelQMUIAlertView)
+	core.SharedOverlayManager().SetDismissHandler(ac.overlay, ac.cancel)
+
+	if ac.Style == ControllerStyleActionSheet && ac.KeyboardAvoidance {
+		ac.keyboard = core.NewKeyboardAvoider(window.Canvas(), content)
+		ac.keyboard.Start()
 	}
 
 	if ac.Delegate != nil {
@@ -284,6 +432,69 @@ func (ac *Alert) ShowIn(window fyne.Window) {
 	}
 }
 
+// contentWidth returns how wide the alert is on a canvas canvasWidth wide
+func (ac *Alert) contentWidth(canvasWidth float32) float32 {
+	base, fraction, limit := ac.AlertContentMaximumWidth, ac.AlertContentWidthFraction, ac.AlertContentWidthLimit
+	if ac.Style == ControllerStyleActionSheet {
+		base, fraction, limit = ac.SheetContentMaximumWidth, ac.SheetContentWidthFraction, ac.SheetContentWidthLimit
+	}
+
+	width := fyne.Max(base, canvasWidth*fraction)
+	if limit > 0 && width > limit {
+		width = fyne.Max(limit, base)
+	}
+	return width
+}
+
+// fitToCanvas sizes the showing alert for its canvas and, for an action
+// sheet that is not modal, moves it to the bottom center. It does nothing
+// until the canvas size changes again.
+func (ac *Alert) fitToCanvas() {
+	ac.mu.Lock()
+	overlay := ac.overlay
+	if overlay == nil || !ac.visible || overlay.Canvas.Size() == ac.fittedSize {
+		ac.mu.Unlock()
+		return
+	}
+	canvasSize := overlay.Canvas.Size()
+	ac.fittedSize = canvasSize
+	ac.mu.Unlock()
+
+	// The overlay size includes the pop-up's padding around the content
+	padding := overlay.MinSize().Width - overlay.Content.MinSize().Width
+	width := fyne.Min(ac.contentWidth(canvasSize.Width), canvasSize.Width-padding)
+	ac.mu.RLock()
+	if ac.headerLayout != nil {
+		ac.headerLayout.width = width
+	}
+	ac.mu.RUnlock()
+	size := overlay.MinSize()
+	size.Width = fyne.Max(size.Width, width+padding)
+	overlay.Resize(size)
+	if ac.Style == ControllerStyleActionSheet && ac.ShouldRespondDimmingViewTouch {
+		overlay.Move(fyne.NewPos(
+			(canvasSize.Width-size.Width)/2,
+			canvasSize.Height-size.Height-20,
+		))
+	}
+}
+
+// cancel performs the first enabled cancel action, as pressing Escape does.
+// Alerts without a cancel action stay open.
+func (ac *Alert) cancel() bool {
+	for _, action := range ac.GetActions() {
+		if action.Style != ActionStyleCancel || !action.Enabled {
+			continue
+		}
+		if action.Handler != nil {
+			action.Handler(ac, action)
+		}
+		ac.Hide()
+		return true
+	}
+	return false
+}
+
 // ShowWithAnimated displays the alert with optional animation
 func (ac *Alert) ShowWithAnimated(window fyne.Window, animated bool) {
 	ac.ShowIn(window)
@@ -306,12 +517,17 @@ func (ac *Alert) Hide() {
 		ac.Delegate.WillHide(ac)
 	}
 
+	if ac.keyboard != nil {
+		ac.keyboard.Stop()
+		ac.keyboard = nil
+	}
 	if ac.overlay != nil {
-		ac.overlay.Hide()
+		core.SharedOverlayManager().Hide(ac.overlay.Canvas, ac.overlay)
 	}
 
 	ac.mu.Lock()
 	ac.visible = false
+	ac.fittedSize = fyne.Size{}
 	ac.mu.Unlock()
 
 	if ac.Delegate != nil {
@@ -319,19 +535,31 @@ func (ac *Alert) Hide() {
 	}
 }
 
+// IsVisible returns whether the alert is showing
+func (ac *Alert) IsVisible() bool {
+	ac.mu.RLock()
+	defer ac.mu.RUnlock()
+	return ac.visible
+}
+
 // HideWithAnimated hides the alert with optional animation
 func (ac *Alert) HideWithAnimated(animated bool) {
 	ac.Hide()
 }
 
 func (ac *Alert) buildContent() fyne.CanvasObject {
+	ac.mu.RLock()
+	window := ac.window
+	ac.mu.RUnlock()
+	width := ac.contentWidth(window.Canvas().Size().Width)
+
 	if ac.Style == ControllerStyleAlert {
-		return ac.buildAlertContent()
+		return ac.buildAlertContent(width)
 	}
-	return ac.buildActionSheetContent()
+	return ac.buildActionSheetContent(width)
 }
 
-func (ac *Alert) buildAlertContent() fyne.CanvasObject {
+func (ac *Alert) buildAlertContent(width float32) fyne.CanvasObject {
 	// Header (title + message)
 	var headerObjects []fyne.CanvasObject
 
@@ -356,8 +584,8 @@ func (ac *Alert) buildAlertContent() fyne.CanvasObject {
 	}
 
 	// Custom view
-	if ac.customView != nil {
-		headerObjects = append(headerObjects, ac.customView)
+	if view := ac.customViewObject(); view != nil {
+		headerObjects = append(headerObjects, view)
 	}
 
 	header := container.NewVBox(headerObjects...)
@@ -392,13 +620,10 @@ func (ac *Alert) buildAlertContent() fyne.CanvasObject {
 		buttons,
 	)
 
-	return container.NewStack(
-		background,
-		container.NewPadded(content),
-	)
+	return ac.withImageHeader(container.NewPadded(content), background, width)
 }
 
-func (ac *Alert) buildActionSheetContent() fyne.CanvasObject {
+func (ac *Alert) buildActionSheetContent(width float32) fyne.CanvasObject {
 	// Header (title + message)
 	var headerObjects []fyne.CanvasObject
 
@@ -417,6 +642,11 @@ func (ac *Alert) buildActionSheetContent() fyne.CanvasObject {
 		headerObjects = append(headerObjects, messageLabel)
 	}
 
+	// Custom view, such as the option list of ShowSingleSelect
+	if view := ac.customViewObject(); view != nil {
+		headerObjects = append(headerObjects, view)
+	}
+
 	// Separate cancel action from other actions
 	var regularActions []*Action
 	var cancelAction *Action
@@ -452,10 +682,7 @@ func (ac *Alert) buildActionSheetContent() fyne.CanvasObject {
 	mainBackground := canvas.NewRectangle(ac.SheetHeaderBackgroundColor)
 	mainBackground.CornerRadius = ac.SheetContentCornerRadius
 
-	mainContent := container.NewStack(
-		mainBackground,
-		container.NewVBox(mainObjects...),
-	)
+	mainContent := ac.withImageHeader(container.NewVBox(mainObjects...), mainBackground, width)
 
 	// Cancel button (separate container)
 	var allContent fyne.CanvasObject
@@ -548,6 +775,11 @@ func (ac *Alert) createActionButton(action *Action) fyne.CanvasObject {
 	}
 	btn.ExtendBaseWidget(btn)
 
+	// Each showing starts with the action ready to tap
+	action.mu.Lock()
+	action.button = btn
+	action.loading = false
+	action.mu.Unlock()
 	return btn
 }
 
@@ -568,21 +800,32 @@ type actionButton struct {
 func (b *actionButton) CreateRenderer() fyne.WidgetRenderer {
 	b.ExtendBaseWidget(b)
 	background := canvas.NewRectangle(b.normalBg)
+	spinner := widget.NewActivity()
+	spinner.Hide()
 	return &actionButtonRenderer{
 		button:     b,
 		background: background,
 		label:      b.label,
+		spinner:    spinner,
 	}
 }
 
 func (b *actionButton) Tapped(_ *fyne.PointEvent) {
-	if !b.action.Enabled {
+	if !b.action.Enabled || b.action.IsLoading() {
 		return
 	}
+	if b.action.Style == ActionStyleDestructive {
+		core.Feedback(core.FeedbackDestructiveConfirm)
+	}
+	if b.action.KeepsAlertOpen {
+		b.action.SetLoading(true)
+	}
 	if b.action.Handler != nil {
 		b.action.Handler(b.controller, b.action)
 	}
-	b.controller.Hide()
+	if !b.action.KeepsAlertOpen {
+		b.controller.Hide()
+	}
 }
 
 func (b *actionButton) TappedSecondary(_ *fyne.PointEvent) {}
@@ -604,7 +847,7 @@ func (b *actionButton) MouseOut() {
 }
 
 func (b *actionButton) Cursor() desktop.Cursor {
-	if b.action.Enabled {
+	if b.action.Enabled && !b.action.IsLoading() {
 		return desktop.PointerCursor
 	}
 	return desktop.DefaultCursor
@@ -614,9 +857,12 @@ type actionButtonRenderer struct {
 	button     *actionButton
 	background *canvas.Rectangle
 	label      *canvas.Text
+	spinner    *widget.Activity
 }
 
-func (r *actionButtonRenderer) Destroy() {}
+func (r *actionButtonRenderer) Destroy() {
+	r.spinner.Stop()
+}
 
 func (r *actionButtonRenderer) Layout(size fyne.Size) {
 	r.background.Resize(size)
@@ -625,6 +871,12 @@ func (r *actionButtonRenderer) Layout(size fyne.Size) {
 		(size.Width-labelSize.Width)/2,
 		(size.Height-labelSize.Height)/2,
 	))
+	spinnerSize := r.spinner.MinSize()
+	r.spinner.Resize(spinnerSize)
+	r.spinner.Move(fyne.NewPos(
+		(size.Width-spinnerSize.Width)/2,
+		(size.Height-spinnerSize.Height)/2,
+	))
 }
 
 func (r *actionButtonRenderer) MinSize() fyne.Size {
@@ -636,18 +888,73 @@ func (r *actionButtonRenderer) Refresh() {
 	r.button.mu.RLock()
 	hovered := r.button.hovered
 	r.button.mu.RUnlock()
+	loading := r.button.action.IsLoading()
 
-	if hovered {
+	if hovered && !loading {
 		r.background.FillColor = r.button.highlightBg
 	} else {
 		r.background.FillColor = r.button.normalBg
 	}
 	r.background.Refresh()
+
+	// While loading, the spinner takes the place of the title
+	if loading {
+		r.label.Hide()
+		if !r.spinner.Visible() {
+			r.spinner.Show()
+			r.spinner.Start()
+		}
+	} else {
+		r.label.Show()
+		r.spinner.Stop()
+		r.spinner.Hide()
+	}
 	r.label.Refresh()
 }
 
 func (r *actionButtonRenderer) Objects() []fyne.CanvasObject {
-	return []fyne.CanvasObject{r.background, r.label}
+	return []fyne.CanvasObject{r.background, r.label, r.spinner}
+}
+
+// alertFrame holds the alert content in its overlay. A canvas refreshes its
+// pop-ups when it resizes, so refreshing the frame refits the alert.
+type alertFrame struct {
+	widget.BaseWidget
+	controller *Alert
+	content    fyne.CanvasObject
+}
+
+func newAlertFrame(controller *Alert, content fyne.CanvasObject) *alertFrame {
+	f := &alertFrame{controller: controller, content: content}
+	f.ExtendBaseWidget(f)
+	return f
+}
+
+func (f *alertFrame) CreateRenderer() fyne.WidgetRenderer {
+	return &alertFrameRenderer{frame: f}
+}
+
+type alertFrameRenderer struct {
+	frame *alertFrame
+}
+
+func (r *alertFrameRenderer) Destroy() {}
+
+func (r *alertFrameRenderer) Layout(size fyne.Size) {
+	r.frame.content.Resize(size)
+}
+
+func (r *alertFrameRenderer) MinSize() fyne.Size {
+	return r.frame.content.MinSize()
+}
+
+func (r *alertFrameRenderer) Refresh() {
+	r.frame.controller.fitToCanvas()
+	r.frame.content.Refresh()
+}
+
+func (r *alertFrameRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.frame.content}
 }
 
 // CreateRenderer implements fyne.Widget
@@ -757,3 +1064,142 @@ func ShowTextInput(window fyne.Window, title, message, placeholder string, onSub
 	ac.ShowIn(window)
 	return ac
 }
+
+// singleSelectVisibleRows is how many options ShowSingleSelect shows before
+// its list scrolls
+const singleSelectVisibleRows = 6
+
+// ShowSingleSelect shows an action sheet listing options with a checkmark on
+// the selected one. Picking an option closes the sheet and calls onPick with
+// its index; the list scrolls when there are more options than fit.
+func ShowSingleSelect(window fyne.Window, title string, options []string, selected int, onPick func(index int)) *Alert {
+	ac := NewAlert(title, "", ControllerStyleActionSheet)
+
+	rows := make([]fyne.CanvasObject, 0, len(options)*2)
+	for i, option := range options {
+		index := i
+		row := &selectRow{
+			title:       option,
+			checked:     i == selected,
+			controller:  ac,
+			highlightBg: ac.SheetButtonHighlightBackgroundColor,
+			onTapped: func() {
+				if onPick != nil {
+					onPick(index)
+				}
+			},
+		}
+		row.ExtendBaseWidget(row)
+		if i > 0 {
+			rows = append(rows, canvas.NewLine(ac.SheetSeparatorColor))
+		}
+		rows = append(rows, row)
+	}
+	ac.AddCustomView(container.NewVBox(rows...))
+	ac.CustomViewMaxHeight = ac.SheetButtonHeight * singleSelectVisibleRows
+	ac.AddCancelAction()
+	ac.ShowIn(window)
+	return ac
+}
+
+// selectRow is an option of a ShowSingleSelect list
+type selectRow struct {
+	widget.BaseWidget
+
+	title       string
+	checked     bool
+	controller  *Alert
+	highlightBg color.Color
+	onTapped    func()
+	hovered     bool
+	mu          sync.RWMutex
+}
+
+func (r *selectRow) CreateRenderer() fyne.WidgetRenderer {
+	r.ExtendBaseWidget(r)
+	label := canvas.NewText(r.title, r.controller.SheetButtonTextColor)
+	label.TextSize = 17
+	checkmark := canvas.NewImageFromResource(theme.NewPrimaryThemedResource(theme.ConfirmIcon()))
+	checkmark.FillMode = canvas.ImageFillContain
+	if !r.checked {
+		checkmark.Hide()
+	}
+	return &selectRowRenderer{
+		row:        r,
+		background: canvas.NewRectangle(color.Transparent),
+		label:      label,
+		checkmark:  checkmark,
+	}
+}
+
+func (r *selectRow) Tapped(_ *fyne.PointEvent) {
+	if r.onTapped != nil {
+		r.onTapped()
+	}
+	r.controller.Hide()
+}
+
+func (r *selectRow) MouseIn(_ *desktop.MouseEvent) {
+	r.mu.Lock()
+	r.hovered = true
+	r.mu.Unlock()
+	r.Refresh()
+}
+
+func (r *selectRow) MouseMoved(_ *desktop.MouseEvent) {}
+
+func (r *selectRow) MouseOut() {
+	r.mu.Lock()
+	r.hovered = false
+	r.mu.Unlock()
+	r.Refresh()
+}
+
+func (r *selectRow) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+type selectRowRenderer struct {
+	row        *selectRow
+	background *canvas.Rectangle
+	label      *canvas.Text
+	checkmark  *canvas.Image
+}
+
+func (r *selectRowRenderer) Destroy() {}
+
+func (r *selectRowRenderer) Layout(size fyne.Size) {
+	padding := theme.Padding() * 2
+	iconSize := theme.IconInlineSize()
+
+	r.background.Resize(size)
+	labelSize := r.label.MinSize()
+	r.label.Move(fyne.NewPos(padding, (size.Height-labelSize.Height)/2))
+	r.label.Resize(fyne.NewSize(size.Width-iconSize-padding*3, labelSize.Height))
+	r.checkmark.Resize(fyne.NewSquareSize(iconSize))
+	r.checkmark.Move(fyne.NewPos(size.Width-iconSize-padding, (size.Height-iconSize)/2))
+}
+
+func (r *selectRowRenderer) MinSize() fyne.Size {
+	padding := theme.Padding() * 2
+	labelSize := r.label.MinSize()
+	return fyne.NewSize(labelSize.Width+theme.IconInlineSize()+padding*3, r.row.controller.SheetButtonHeight)
+}
+
+func (r *selectRowRenderer) Refresh() {
+	r.row.mu.RLock()
+	hovered := r.row.hovered
+	r.row.mu.RUnlock()
+
+	if hovered {
+		r.background.FillColor = r.row.highlightBg
+	} else {
+		r.background.FillColor = color.Transparent
+	}
+	r.background.Refresh()
+	r.label.Refresh()
+}
+
+func (r *selectRowRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.background, r.label, r.checkmark}
+}
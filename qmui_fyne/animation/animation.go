@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"fyne.io/fyne/v2"
+
+	"github.com/paul-hammant/qmui_fyne/core"
 )
 
 // EasingFunction defines an easing function type
@@ -200,6 +202,19 @@ type Animation struct {
 	running   bool
 	cancelled bool
 	stopChan  chan struct{}
+
+	// newStepper, when set, replaces duration and easing based timing.
+	// It is called on each start and returns a function reporting the
+	// progress at the elapsed time and whether the animation has finished.
+	newStepper func() func(elapsed time.Duration) (progress float64, done bool)
+
+	// afterComplete is called after OnComplete when used in a Group or Sequence
+	afterComplete func()
+
+	// Playback state for duration based animations
+	position float64
+	reversed bool
+	paused   bool
 }
 
 // NewAnimation creates a new animation
@@ -213,17 +228,33 @@ func NewAnimation(duration time.Duration, easing EasingFunction, onUpdate func(f
 
 // Start begins the animation
 func (a *Animation) Start() {
+	a.mu.Lock()
+	if a.running {
+		a.mu.Unlock()
+		return
+	}
+	a.position = 0
+	a.reversed = false
+	a.mu.Unlock()
+
+	a.play()
+}
+
+// play runs the animation from its current position
+func (a *Animation) play() {
 	a.mu.Lock()
 	if a.running {
 		a.mu.Unlock()
 		return
 	}
 	a.running = true
+	a.paused = false
 	a.cancelled = false
 	a.stopChan = make(chan struct{})
+	stopChan := a.stopChan
 	a.mu.Unlock()
 
-	go a.run()
+	go a.run(stopChan)
 }
 
 // Stop stops the animation
@@ -233,13 +264,75 @@ func (a *Animation) Stop() {
 		a.mu.Unlock()
 		return
 	}
+	a.running = false
+	a.paused = false
 	a.cancelled = true
 	if a.stopChan != nil {
 		close(a.stopChan)
+		a.stopChan = nil
+	}
+	a.mu.Unlock()
+}
+
+// Pause freezes a running animation at its current position
+func (a *Animation) Pause() {
+	a.mu.Lock()
+	if a.running {
+		a.paused = true
 	}
 	a.mu.Unlock()
 }
 
+// Resume continues a paused animation
+func (a *Animation) Resume() {
+	a.mu.Lock()
+	a.paused = false
+	a.mu.Unlock()
+}
+
+// IsPaused returns whether the animation is paused
+func (a *Animation) IsPaused() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.paused
+}
+
+// Reverse animates back towards the start from the current position
+func (a *Animation) Reverse() {
+	a.mu.Lock()
+	a.reversed = true
+	a.paused = false
+	a.mu.Unlock()
+
+	a.play()
+}
+
+// Progress returns the normalized time position of the animation (0.0 - 1.0)
+func (a *Animation) Progress() float64 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.position
+}
+
+// SetProgress jumps to a normalized time position (0.0 - 1.0) and updates
+// the value without running the animation
+func (a *Animation) SetProgress(position float64) {
+	position = math.Max(0, math.Min(1, position))
+
+	a.mu.Lock()
+	a.position = position
+	easing := a.Easing
+	a.mu.Unlock()
+
+	progress := position
+	if easing != nil {
+		progress = easing(position)
+	}
+	if a.OnUpdate != nil {
+		a.OnUpdate(progress)
+	}
+}
+
 // IsRunning returns whether the animation is running
 func (a *Animation) IsRunning() bool {
 	a.mu.RLock()
@@ -247,54 +340,124 @@ func (a *Animation) IsRunning() bool {
 	return a.running
 }
 
-func (a *Animation) run() {
-	startTime := time.Now()
+func (a *Animation) run(stopChan chan struct{}) {
+	if core.ReducedMotion() {
+		a.jumpToEnd(stopChan)
+		return
+	}
+
+	last := time.Now()
+	var elapsed time.Duration
 	ticker := time.NewTicker(time.Millisecond * 16) // ~60fps
 	defer ticker.Stop()
 
+	var step func(elapsed time.Duration) (float64, bool)
+	if a.newStepper != nil {
+		step = a.newStepper()
+	}
+
 	for {
 		select {
-		case <-a.stopChan:
-			a.mu.Lock()
-			a.running = false
-			a.mu.Unlock()
+		case <-stopChan:
 			return
-		case <-ticker.C:
-			elapsed := time.Since(startTime)
-			t := float64(elapsed) / float64(a.Duration)
+		case now := <-ticker.C:
+			delta := now.Sub(last)
+			last = now
 
-			if t >= 1 {
-				t = 1
+			a.mu.Lock()
+			if a.paused {
+				a.mu.Unlock()
+				continue
 			}
+			elapsed += delta
 
-			a.mu.RLock()
-			easing := a.Easing
-			a.mu.RUnlock()
+			var progress float64
+			var done bool
+			if step != nil {
+				a.mu.Unlock()
+				progress, done = step(elapsed)
+			} else {
+				if a.Duration > 0 {
+					change := float64(delta) / float64(a.Duration)
+					if a.reversed {
+						change = -change
+					}
+					a.position = math.Max(0, math.Min(1, a.position+change))
+				} else if a.reversed {
+					a.position = 0
+				} else {
+					a.position = 1
+				}
+				done = (!a.reversed && a.position >= 1) || (a.reversed && a.position <= 0)
+				position := a.position
+				easing := a.Easing
+				a.mu.Unlock()
 
-			progress := t
-			if easing != nil {
-				progress = easing(t)
+				progress = position
+				if easing != nil {
+					progress = easing(position)
+				}
 			}
 
 			if a.OnUpdate != nil {
 				a.OnUpdate(progress)
 			}
 
-			if t >= 1 {
-				a.mu.Lock()
-				a.running = false
-				onComplete := a.OnComplete
-				a.mu.Unlock()
-
-				if onComplete != nil {
-					onComplete()
-				}
+			if done {
+				a.complete(stopChan)
 				return
 			}
 		}
 	}
 }
 
+// jumpToEnd shows the final frame and completes at once, for reduced motion
+func (a *Animation) jumpToEnd(stopChan chan struct{}) {
+	a.mu.Lock()
+	end := 1.0
+	if a.reversed && a.newStepper == nil {
+		end = 0
+	}
+	a.position = end
+	easing := a.Easing
+	if a.newStepper != nil {
+		easing = nil
+	}
+	a.mu.Unlock()
+
+	progress := end
+	if easing != nil {
+		progress = easing(end)
+	}
+	if a.OnUpdate != nil {
+		a.OnUpdate(progress)
+	}
+	a.complete(stopChan)
+}
+
+// complete marks the animation finished and calls the completion handlers,
+// unless it was stopped meanwhile
+func (a *Animation) complete(stopChan chan struct{}) {
+	a.mu.Lock()
+	if a.stopChan != stopChan {
+		// Stopped while updating
+		a.mu.Unlock()
+		return
+	}
+	a.running = false
+	a.stopChan = nil
+	onComplete := a.OnComplete
+	afterComplete := a.afterComplete
+	a.mu.Unlock()
+
+	if onComplete != nil {
+		onComplete()
+	}
+	if afterComplete != nil {
+		afterComplete()
+	}
+}
+
 // Animator manages multiple animations
 type Animator struct {
 	animations []*Animation
@@ -337,6 +500,170 @@ func (an *Animator) StopAll() {
 	}
 }
 
+// Group runs animations in parallel and calls OnComplete when all finish
+type Group struct {
+	Animations []*Animation
+	OnComplete func()
+
+	mu        sync.Mutex
+	running   bool
+	remaining int
+}
+
+// NewGroup creates a group of animations that run in parallel
+func NewGroup(animations ...*Animation) *Group {
+	g := &Group{Animations: animations}
+	for _, anim := range animations {
+		anim.mu.Lock()
+		anim.afterComplete = g.animationFinished
+		anim.mu.Unlock()
+	}
+	return g
+}
+
+// Start starts all animations in the group
+func (g *Group) Start() {
+	g.mu.Lock()
+	if g.running {
+		g.mu.Unlock()
+		return
+	}
+	g.running = true
+	g.remaining = len(g.Animations)
+	g.mu.Unlock()
+
+	if len(g.Animations) == 0 {
+		g.finish()
+		return
+	}
+	for _, anim := range g.Animations {
+		anim.Start()
+	}
+}
+
+// Stop stops all animations in the group without calling OnComplete
+func (g *Group) Stop() {
+	g.mu.Lock()
+	g.running = false
+	g.mu.Unlock()
+
+	for _, anim := range g.Animations {
+		anim.Stop()
+	}
+}
+
+// IsRunning returns whether the group is running
+func (g *Group) IsRunning() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.running
+}
+
+func (g *Group) animationFinished() {
+	g.mu.Lock()
+	if !g.running {
+		g.mu.Unlock()
+		return
+	}
+	g.remaining--
+	done := g.remaining == 0
+	g.mu.Unlock()
+
+	if done {
+		g.finish()
+	}
+}
+
+func (g *Group) finish() {
+	g.mu.Lock()
+	g.running = false
+	onComplete := g.OnComplete
+	g.mu.Unlock()
+
+	if onComplete != nil {
+		onComplete()
+	}
+}
+
+// Sequence runs animations one after another and calls OnComplete after the last
+type Sequence struct {
+	Animations []*Animation
+	OnComplete func()
+
+	mu      sync.Mutex
+	running bool
+	current int
+}
+
+// NewSequence creates a sequence of animations that run one after another
+func NewSequence(animations ...*Animation) *Sequence {
+	s := &Sequence{Animations: animations}
+	for i, anim := range animations {
+		next := i + 1
+		anim.mu.Lock()
+		anim.afterComplete = func() {
+			s.startAt(next)
+		}
+		anim.mu.Unlock()
+	}
+	return s
+}
+
+// Start starts the first animation of the sequence
+func (s *Sequence) Start() {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = true
+	s.mu.Unlock()
+
+	s.startAt(0)
+}
+
+// Stop stops the running animation without calling OnComplete
+func (s *Sequence) Stop() {
+	s.mu.Lock()
+	s.running = false
+	current := s.current
+	s.mu.Unlock()
+
+	if current < len(s.Animations) {
+		s.Animations[current].Stop()
+	}
+}
+
+// IsRunning returns whether the sequence is running
+func (s *Sequence) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *Sequence) startAt(index int) {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.current = index
+	if index >= len(s.Animations) {
+		s.running = false
+		onComplete := s.OnComplete
+		s.mu.Unlock()
+
+		if onComplete != nil {
+			onComplete()
+		}
+		return
+	}
+	anim := s.Animations[index]
+	s.mu.Unlock()
+
+	anim.Start()
+}
+
 // PropertyAnimation animates a property between values
 type PropertyAnimation struct {
 	*Animation
@@ -361,6 +688,75 @@ func NewPropertyAnimation(from, to float64, duration time.Duration, easing Easin
 	return pa
 }
 
+// Default spring parameters giving a quick settle with a slight overshoot
+const (
+	DefaultSpringStiffness = 170.0
+	DefaultSpringDamping   = 26.0
+	DefaultSpringTolerance = 0.001
+)
+
+// SpringAnimation animates a value with damped spring physics
+type SpringAnimation struct {
+	*Animation
+	FromValue float64
+	ToValue   float64
+	Stiffness float64
+	Damping   float64
+	Tolerance float64
+}
+
+// NewSpringAnimation creates a spring animation that calls update each frame
+// until the spring settles at to within Tolerance
+func NewSpringAnimation(from, to float64, stiffness, damping float64, update func(float64)) *SpringAnimation {
+	sa := &SpringAnimation{
+		FromValue: from,
+		ToValue:   to,
+		Stiffness: stiffness,
+		Damping:   damping,
+		Tolerance: DefaultSpringTolerance,
+	}
+
+	sa.Animation = NewAnimation(0, nil, func(progress float64) {
+		if update != nil {
+			update(sa.FromValue + (sa.ToValue-sa.FromValue)*progress)
+		}
+	})
+	sa.newStepper = sa.newSpringStepper
+
+	return sa
+}
+
+// newSpringStepper integrates the spring in normalized space from 0 to 1
+func (sa *SpringAnimation) newSpringStepper() func(elapsed time.Duration) (float64, bool) {
+	const dt = time.Millisecond
+	x, v := 0.0, 0.0
+	var simulated time.Duration
+
+	return func(elapsed time.Duration) (float64, bool) {
+		for simulated < elapsed {
+			x, v = springStep(x, v, sa.Stiffness, sa.Damping, dt.Seconds())
+			simulated += dt
+			if springSettled(x, v, sa.Tolerance) {
+				return 1, true
+			}
+		}
+		return x, false
+	}
+}
+
+// springStep advances a unit-mass spring pulled towards 1 by dt seconds
+func springStep(x, v, stiffness, damping, dt float64) (float64, float64) {
+	force := -stiffness*(x-1) - damping*v
+	v += force * dt
+	x += v * dt
+	return x, v
+}
+
+// springSettled returns whether the spring is at rest within tolerance
+func springSettled(x, v, tolerance float64) bool {
+	return math.Abs(x-1) < tolerance && math.Abs(v) < tolerance
+}
+
 // ColorAnimation animates between two colors
 type ColorAnimation struct {
 	*Animation
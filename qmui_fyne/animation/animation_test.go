@@ -0,0 +1,313 @@
+package animation
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/paul-hammant/qmui_fyne/core"
+)
+
+func TestSpringAnimation_ConvergesToTarget(t *testing.T) {
+	var mu sync.Mutex
+	var values []float64
+	done := make(chan struct{})
+
+	spring := NewSpringAnimation(10, 50, DefaultSpringStiffness, DefaultSpringDamping, func(v float64) {
+		mu.Lock()
+		values = append(values, v)
+		mu.Unlock()
+	})
+	spring.OnComplete = func() {
+		close(done)
+	}
+	spring.Start()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		spring.Stop()
+		t.Fatal("Spring did not settle")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(values) < 2 {
+		t.Fatalf("Expected per-frame updates, got %d", len(values))
+	}
+	if last := values[len(values)-1]; last != 50 {
+		t.Errorf("Final value = %v, want 50", last)
+	}
+	if spring.IsRunning() {
+		t.Error("Spring should stop once settled")
+	}
+}
+
+func TestSpringStep_Overshoots(t *testing.T) {
+	// An underdamped spring overshoots before settling
+	x, v := 0.0, 0.0
+	peak := 0.0
+	for i := 0; i < 5000 && !springSettled(x, v, DefaultSpringTolerance); i++ {
+		x, v = springStep(x, v, 300, 10, 0.001)
+		peak = math.Max(peak, x)
+	}
+	if peak <= 1 {
+		t.Errorf("Underdamped spring should overshoot, peak %v", peak)
+	}
+	if math.Abs(x-1) >= DefaultSpringTolerance {
+		t.Errorf("Spring should settle at 1, got %v", x)
+	}
+}
+
+func TestGroup_CompletesWhenAllFinish(t *testing.T) {
+	var mu sync.Mutex
+	finished := map[string]bool{}
+	mark := func(name string) func() {
+		return func() {
+			mu.Lock()
+			finished[name] = true
+			mu.Unlock()
+		}
+	}
+
+	short := NewAnimation(20*time.Millisecond, Linear, nil)
+	short.OnComplete = mark("short")
+	long := NewPropertyAnimation(0, 1, 60*time.Millisecond, EaseOutQuad, nil)
+	long.OnComplete = mark("long")
+
+	done := make(chan struct{})
+	group := NewGroup(short, long.Animation)
+	group.OnComplete = func() {
+		mu.Lock()
+		if !finished["short"] || !finished["long"] {
+			t.Error("Group should complete after all animations finish")
+		}
+		mu.Unlock()
+		close(done)
+	}
+	group.Start()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Group did not complete")
+	}
+	if group.IsRunning() {
+		t.Error("Group should not be running after completion")
+	}
+}
+
+func TestSequence_RunsInOrder(t *testing.T) {
+	var mu sync.Mutex
+	var order []int
+	record := func(i int) func() {
+		return func() {
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+		}
+	}
+
+	first := NewAnimation(20*time.Millisecond, Linear, nil)
+	first.OnComplete = record(1)
+	second := NewAnimation(20*time.Millisecond, Linear, nil)
+	second.OnComplete = record(2)
+	third := NewSpringAnimation(0, 1, DefaultSpringStiffness, DefaultSpringDamping, nil)
+	third.OnComplete = record(3)
+
+	done := make(chan struct{})
+	sequence := NewSequence(first, second, third.Animation)
+	sequence.OnComplete = func() {
+		close(done)
+	}
+	sequence.Start()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("Sequence did not complete")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(order) != 3 || order[0] != 1 || order[1] != 2 || order[2] != 3 {
+		t.Errorf("Sequence order = %v, want [1 2 3]", order)
+	}
+}
+
+func TestPropertyAnimation_PauseResume(t *testing.T) {
+	var mu sync.Mutex
+	var value float64
+	updated := make(chan struct{}, 1)
+	anim := NewPropertyAnimation(0, 100, 200*time.Millisecond, Linear, func(v float64) {
+		mu.Lock()
+		value = v
+		mu.Unlock()
+		select {
+		case updated <- struct{}{}:
+		default:
+		}
+	})
+	done := make(chan struct{})
+	anim.OnComplete = func() {
+		close(done)
+	}
+
+	anim.Start()
+	<-updated
+	anim.Pause()
+	if !anim.IsPaused() {
+		t.Fatal("Animation should be paused")
+	}
+	paused := anim.Progress()
+	// Give the ticker a few frames in which it must not advance
+	time.Sleep(50 * time.Millisecond)
+	if anim.Progress() != paused {
+		t.Error("Progress should not advance while paused")
+	}
+	if !anim.IsRunning() {
+		t.Error("A paused animation is still running")
+	}
+
+	anim.Resume()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Resumed animation did not complete")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if value != 100 {
+		t.Errorf("Final value = %v, want 100", value)
+	}
+}
+
+func TestPropertyAnimation_ReverseAndSetProgress(t *testing.T) {
+	var mu sync.Mutex
+	var value float64
+	anim := NewPropertyAnimation(10, 20, 100*time.Millisecond, Linear, func(v float64) {
+		mu.Lock()
+		value = v
+		mu.Unlock()
+	})
+
+	anim.SetProgress(0.5)
+	if anim.IsRunning() {
+		t.Error("SetProgress should not start the animation")
+	}
+	mu.Lock()
+	if value != 15 {
+		t.Errorf("SetProgress(0.5) value = %v, want 15", value)
+	}
+	mu.Unlock()
+
+	done := make(chan struct{})
+	anim.OnComplete = func() {
+		close(done)
+	}
+	anim.Reverse()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Reversed animation did not complete")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if value != 10 || anim.Progress() != 0 {
+		t.Errorf("Reverse should return to the origin, got %v", value)
+	}
+}
+
+func TestAnimation_Stop(t *testing.T) {
+	completed := false
+	anim := NewAnimation(20*time.Millisecond, Linear, nil)
+	anim.OnComplete = func() {
+		completed = true
+	}
+	anim.Start()
+	anim.Stop()
+	if anim.IsRunning() {
+		t.Error("Stop should stop the animation")
+	}
+	// Wait past the end it would have reached
+	time.Sleep(50 * time.Millisecond)
+	if completed {
+		t.Error("A stopped animation should not complete")
+	}
+}
+
+func TestAnimation_ReducedMotion(t *testing.T) {
+	core.SetReducedMotion(true)
+	defer core.SetReducedMotion(false)
+
+	var mu sync.Mutex
+	var values []float64
+	done := make(chan struct{})
+
+	anim := NewPropertyAnimation(0, 100, time.Hour, EaseOutCubic, func(v float64) {
+		mu.Lock()
+		values = append(values, v)
+		mu.Unlock()
+	})
+	anim.OnComplete = func() { close(done) }
+	anim.Start()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("An hour-long animation should finish at once with reduced motion")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(values) != 1 || values[0] != 100 {
+		t.Errorf("Reduced motion should jump straight to the final value, got %v", values)
+	}
+}
+
+func TestLayoutTransition_RetargetAndStop(t *testing.T) {
+	test.NewTempApp(t)
+
+	kept := canvas.NewRectangle(nil)
+	kept.Resize(fyne.NewSize(10, 10))
+	removed := canvas.NewRectangle(nil)
+	removed.Resize(fyne.NewSize(10, 10))
+	removed.Move(fyne.NewPos(20, 0))
+	added := canvas.NewRectangle(nil)
+
+	changes := 0
+	lt := NewLayoutTransition()
+	lt.Duration = time.Second
+	lt.OnObjectsChanged = func() { changes++ }
+
+	lt.Animate([]fyne.CanvasObject{kept, removed}, []fyne.CanvasObject{kept, added}, []LayoutFrame{
+		{Position: fyne.NewPos(0, 0), Size: fyne.NewSize(10, 10)},
+		{Position: fyne.NewPos(20, 0), Size: fyne.NewSize(10, 10)},
+	})
+	if !lt.IsRunning() || changes != 1 {
+		t.Fatal("Animate should start the transition and report the removed objects")
+	}
+	if objects := lt.Objects(); len(objects) != 1 || objects[0] != removed {
+		t.Errorf("Objects should hold the removed object, without covers, got %v", objects)
+	}
+	if added.Size() != fyne.NewSize(0, 0) || added.Position() != fyne.NewPos(25, 5) {
+		t.Errorf("Added object should start collapsed at its center, got %v at %v", added.Size(), added.Position())
+	}
+
+	lt.Retarget([]fyne.CanvasObject{kept, added}, []LayoutFrame{
+		{Position: fyne.NewPos(0, 0), Size: fyne.NewSize(10, 10)},
+		{Position: fyne.NewPos(40, 0), Size: fyne.NewSize(20, 10)},
+	})
+	lt.Stop()
+	if lt.IsRunning() || len(lt.Objects()) != 0 {
+		t.Error("Stop should end the transition and drop the removed objects")
+	}
+	if added.Position() != fyne.NewPos(40, 0) || added.Size() != fyne.NewSize(20, 10) {
+		t.Errorf("Stop should place objects at their retargeted frames, got %v at %v", added.Size(), added.Position())
+	}
+}
@@ -0,0 +1,274 @@
+package animation
+
+import (
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+
+	"github.com/paul-hammant/qmui_fyne/core"
+)
+
+// layoutTransitionDuration is how long a LayoutTransition takes by default
+const layoutTransitionDuration = time.Millisecond * 250
+
+// LayoutFrame is the position and size of an object in a layout
+type LayoutFrame struct {
+	Position fyne.Position
+	Size     fyne.Size
+}
+
+// LayoutTransition animates the objects of a layout widget to a new layout
+// after objects are added or removed. Objects that stay slide from where
+// they are to their new frame, added objects grow in from the center of
+// their frame and removed objects shrink away into theirs.
+//
+// When CoverColor is opaque, normally the background of the layout, added
+// objects also fade in from it and removed objects fade out to it. Canvas
+// objects have no opacity of their own, so the fade is drawn by covering
+// them with CoverColor, and without an opaque CoverColor they don't fade.
+type LayoutTransition struct {
+	Duration   time.Duration
+	Easing     EasingFunction
+	CoverColor color.Color
+
+	// OnObjectsChanged is called when the objects returned by Objects
+	// change, so the owning widget can refresh
+	OnObjectsChanged func()
+
+	mu       sync.Mutex
+	anim     *Animation
+	tracks   map[fyne.CanvasObject]*layoutTrack
+	removing []fyne.CanvasObject
+	covers   []fyne.CanvasObject
+}
+
+// layoutTrack is the path of one object through a transition
+type layoutTrack struct {
+	from, to LayoutFrame
+	cover    *canvas.Rectangle
+	removed  bool
+}
+
+// NewLayoutTransition creates a layout transition with the default
+// duration and easing
+func NewLayoutTransition() *LayoutTransition {
+	return &LayoutTransition{
+		Duration: layoutTransitionDuration,
+		Easing:   EaseOutCubic,
+	}
+}
+
+// Animate moves the objects shown before a change, from where they are, to
+// objects laid out at frames, which holds a frame for each object
+func (lt *LayoutTransition) Animate(before, objects []fyne.CanvasObject, frames []LayoutFrame) {
+	lt.Stop()
+
+	shown := make(map[fyne.CanvasObject]bool, len(before))
+	for _, obj := range before {
+		shown[obj] = true
+	}
+	kept := make(map[fyne.CanvasObject]bool, len(objects))
+	tracks := make(map[fyne.CanvasObject]*layoutTrack, len(objects)+len(before))
+	var removing, covers []fyne.CanvasObject
+
+	for i, obj := range objects {
+		kept[obj] = true
+		if shown[obj] {
+			tracks[obj] = &layoutTrack{from: frameOf(obj), to: frames[i]}
+			continue
+		}
+		track := &layoutTrack{from: collapsedFrame(frames[i]), to: frames[i], cover: lt.newCover()}
+		if track.cover != nil {
+			covers = append(covers, track.cover)
+		}
+		tracks[obj] = track
+	}
+	for _, obj := range before {
+		if kept[obj] {
+			continue
+		}
+		from := frameOf(obj)
+		track := &layoutTrack{from: from, to: collapsedFrame(from), cover: lt.newCover(), removed: true}
+		if track.cover != nil {
+			covers = append(covers, track.cover)
+		}
+		tracks[obj] = track
+		removing = append(removing, obj)
+	}
+
+	var anim *Animation
+	anim = NewAnimation(lt.Duration, lt.Easing, func(progress float64) {
+		fyne.Do(func() {
+			lt.step(anim, progress)
+		})
+	})
+	anim.OnComplete = func() {
+		fyne.Do(func() {
+			lt.finish(anim)
+		})
+	}
+
+	lt.mu.Lock()
+	lt.anim = anim
+	lt.tracks = tracks
+	lt.removing = removing
+	lt.covers = covers
+	lt.mu.Unlock()
+
+	// Added objects start collapsed rather than at their final frame
+	lt.step(anim, 0)
+	lt.notify()
+	anim.Start()
+}
+
+// Retarget changes where objects, which are moving in the running
+// transition, end up, as when the layout is resized during it
+func (lt *LayoutTransition) Retarget(objects []fyne.CanvasObject, frames []LayoutFrame) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	for i, obj := range objects {
+		if track := lt.tracks[obj]; track != nil && !track.removed {
+			track.to = frames[i]
+		}
+	}
+}
+
+// Stop ends the transition at once, placing the objects at their final
+// frames and dropping the removed ones. It does not call OnObjectsChanged.
+func (lt *LayoutTransition) Stop() {
+	lt.mu.Lock()
+	anim := lt.anim
+	tracks := lt.tracks
+	lt.anim = nil
+	lt.tracks = nil
+	lt.removing = nil
+	lt.covers = nil
+	lt.mu.Unlock()
+
+	if anim == nil {
+		return
+	}
+	anim.Stop()
+	placeFinal(tracks)
+}
+
+// IsRunning returns whether a transition is running
+func (lt *LayoutTransition) IsRunning() bool {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	return lt.anim != nil
+}
+
+// Objects returns the removed objects still shrinking away, then the
+// covers fading added and removed objects, to draw above the layout
+func (lt *LayoutTransition) Objects() []fyne.CanvasObject {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	objects := make([]fyne.CanvasObject, 0, len(lt.removing)+len(lt.covers))
+	objects = append(objects, lt.removing...)
+	return append(objects, lt.covers...)
+}
+
+// step places every object progress of the way along its track
+func (lt *LayoutTransition) step(anim *Animation, progress float64) {
+	lt.mu.Lock()
+	if lt.anim != anim {
+		lt.mu.Unlock()
+		return
+	}
+	tracks := make(map[fyne.CanvasObject]layoutTrack, len(lt.tracks))
+	for obj, track := range lt.tracks {
+		tracks[obj] = *track
+	}
+	coverColor := lt.CoverColor
+	lt.mu.Unlock()
+
+	for obj, track := range tracks {
+		frame := lerpFrame(track.from, track.to, float32(progress))
+		obj.Resize(frame.Size)
+		obj.Move(frame.Position)
+
+		if track.cover == nil {
+			continue
+		}
+		alpha := 1 - progress
+		if track.removed {
+			alpha = progress
+		}
+		track.cover.FillColor = core.ColorWithAlpha(coverColor, alpha)
+		track.cover.Resize(frame.Size)
+		track.cover.Move(frame.Position)
+		track.cover.Refresh()
+	}
+}
+
+// finish places the objects at their final frames once anim completes
+func (lt *LayoutTransition) finish(anim *Animation) {
+	lt.mu.Lock()
+	if lt.anim != anim {
+		lt.mu.Unlock()
+		return
+	}
+	tracks := lt.tracks
+	lt.anim = nil
+	lt.tracks = nil
+	lt.removing = nil
+	lt.covers = nil
+	lt.mu.Unlock()
+
+	placeFinal(tracks)
+	lt.notify()
+}
+
+func (lt *LayoutTransition) notify() {
+	if lt.OnObjectsChanged != nil {
+		lt.OnObjectsChanged()
+	}
+}
+
+// newCover returns a rectangle to fade an object with, or nil if
+// CoverColor is not opaque
+func (lt *LayoutTransition) newCover() *canvas.Rectangle {
+	if lt.CoverColor == nil {
+		return nil
+	}
+	if _, _, _, a := lt.CoverColor.RGBA(); a != 0xffff {
+		return nil
+	}
+	return canvas.NewRectangle(lt.CoverColor)
+}
+
+// placeFinal moves the objects that stay to the end of their tracks
+func placeFinal(tracks map[fyne.CanvasObject]*layoutTrack) {
+	for obj, track := range tracks {
+		if track.removed {
+			continue
+		}
+		obj.Resize(track.to.Size)
+		obj.Move(track.to.Position)
+	}
+}
+
+func frameOf(obj fyne.CanvasObject) LayoutFrame {
+	return LayoutFrame{Position: obj.Position(), Size: obj.Size()}
+}
+
+// collapsedFrame returns an empty frame at the center of frame
+func collapsedFrame(frame LayoutFrame) LayoutFrame {
+	return LayoutFrame{
+		Position: frame.Position.AddXY(frame.Size.Width/2, frame.Size.Height/2),
+	}
+}
+
+func lerpFrame(from, to LayoutFrame, progress float32) LayoutFrame {
+	lerp := func(a, b float32) float32 {
+		return a + (b-a)*progress
+	}
+	return LayoutFrame{
+		Position: fyne.NewPos(lerp(from.Position.X, to.Position.X), lerp(from.Position.Y, to.Position.Y)),
+		Size:     fyne.NewSize(lerp(from.Size.Width, to.Size.Width), lerp(from.Size.Height, to.Size.Height)),
+	}
+}
@@ -27,6 +27,10 @@ type Badge struct {
 	CornerRadius      float32
 	MinimumSize       fyne.Size
 
+	// MaxWidth caps the width of the badge, truncating longer text with an
+	// ellipsis. 0 means no cap.
+	MaxWidth float32
+
 	mu sync.RWMutex
 }
 
@@ -92,7 +96,7 @@ func (r *badgeLabelRenderer) MinSize() fyne.Size {
 	text := r.badge.Text
 	r.badge.mu.RUnlock()
 
-	r.text.Text = text
+	r.text.Text = r.displayText(text)
 	textSize := r.text.MinSize()
 	insets := r.badge.ContentEdgeInsets
 
@@ -105,17 +109,46 @@ func (r *badgeLabelRenderer) MinSize() fyne.Size {
 	if height < r.badge.MinimumSize.Height {
 		height = r.badge.MinimumSize.Height
 	}
+	if maxWidth := r.badge.MaxWidth; maxWidth > 0 && width > maxWidth {
+		// Never narrower than tall, so the badge keeps its pill shape
+		width = fyne.Max(maxWidth, height)
+	}
 
 	return fyne.NewSize(width, height)
 }
 
+// displayText returns text shortened with an ellipsis until it fits within
+// MaxWidth, less the horizontal insets
+func (r *badgeLabelRenderer) displayText(text string) string {
+	if r.badge.MaxWidth <= 0 {
+		return text
+	}
+	insets := r.badge.ContentEdgeInsets
+	available := r.badge.MaxWidth - insets.Left - insets.Right
+	measure := func(s string) float32 {
+		return fyne.MeasureText(s, r.badge.FontSize, r.text.TextStyle).Width
+	}
+	if measure(text) <= available {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if candidate := string(runes) + "…"; measure(candidate) <= available {
+			return candidate
+		}
+	}
+	return "…"
+}
+
 func (r *badgeLabelRenderer) Refresh() {
 	r.badge.mu.RLock()
 	text := r.badge.Text
 	r.badge.mu.RUnlock()
 
 	r.background.FillColor = r.badge.BackgroundColor
-	r.text.Text = text
+	r.text.Text = r.displayText(text)
 	r.text.Color = r.badge.TextColor
 	r.text.TextSize = r.badge.FontSize
 
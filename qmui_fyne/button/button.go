@@ -4,7 +4,9 @@ package button
 
 import (
 	"image/color"
+	"math"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -12,7 +14,9 @@ import (
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/paul-hammant/qmui_fyne/animation"
 	"github.com/paul-hammant/qmui_fyne/core"
+	"github.com/paul-hammant/qmui_fyne/popup"
 	qmuitheme "github.com/paul-hammant/qmui_fyne/theme"
 )
 
@@ -66,6 +70,17 @@ type Button struct {
 	AdjustsButtonWhenDisabled          bool
 	Enabled                            bool
 
+	// DisabledReason is shown in a tooltip when hovering the disabled button
+	DisabledReason string
+
+	// AccessibilityLabel is announced by screen readers, defaulting to Text
+	AccessibilityLabel string
+
+	// RippleEffect animates a highlight expanding from the press point.
+	// RippleColor defaults to a lightened BackgroundColor.
+	RippleEffect bool
+	RippleColor  color.Color
+
 	// Callbacks
 	OnTapped func()
 
@@ -74,8 +89,17 @@ type Button struct {
 	hovered     bool
 	pressed     bool
 	highlighted bool
+	focused     bool
+	reasonTip   *popup.Tooltip
+
+	ripple         *animation.Animation
+	rippleCenter   fyne.Position
+	rippleProgress float64
 }
 
+// rippleDuration is how long the ripple takes to cover the button and fade
+const rippleDuration = 450 * time.Millisecond
+
 // NewButton creates a new QMUI-styled button with text
 func NewButton(text string, tapped func()) *Button {
 	config := core.SharedConfiguration()
@@ -122,14 +146,116 @@ func (b *Button) SetTintColorAdjustsTitleAndImage(tintColor color.Color) {
 	b.Refresh()
 }
 
+// startRipple animates a ripple outward from center
+func (b *Button) startRipple(center fyne.Position) {
+	b.mu.Lock()
+	if b.ripple != nil {
+		b.ripple.Stop()
+	}
+	b.rippleCenter = center
+	b.rippleProgress = 0
+	var ripple *animation.Animation
+	ripple = animation.NewAnimation(rippleDuration, animation.EaseOutCubic, func(p float64) {
+		b.mu.Lock()
+		if b.ripple != ripple {
+			b.mu.Unlock()
+			return
+		}
+		b.rippleProgress = p
+		if p >= 1 {
+			b.ripple = nil
+		}
+		b.mu.Unlock()
+		fyne.Do(b.Refresh)
+	})
+	b.ripple = ripple
+	b.mu.Unlock()
+
+	ripple.Start()
+	b.Refresh()
+}
+
+// isRippling returns whether a ripple is animating
+func (b *Button) isRippling() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.ripple != nil
+}
+
 // SetEnabled sets the button enabled state
 func (b *Button) SetEnabled(enabled bool) {
 	b.mu.Lock()
 	b.Enabled = enabled
 	b.mu.Unlock()
+	if enabled {
+		b.hideDisabledReason()
+	}
 	b.Refresh()
 }
 
+// SetDisabledReason sets the text shown on hover while the button is disabled
+func (b *Button) SetDisabledReason(reason string) {
+	b.mu.Lock()
+	b.DisabledReason = reason
+	tip := b.reasonTip
+	b.mu.Unlock()
+	if tip != nil {
+		tip.SetText(reason)
+	}
+	if reason == "" {
+		b.hideDisabledReason()
+	}
+}
+
+// IsShowingDisabledReason returns whether the disabled reason tooltip is visible
+func (b *Button) IsShowingDisabledReason() bool {
+	b.mu.RLock()
+	tip := b.reasonTip
+	b.mu.RUnlock()
+	return tip != nil && tip.IsShowing()
+}
+
+// showDisabledReason schedules the disabled reason tooltip if one applies
+func (b *Button) showDisabledReason() {
+	b.mu.Lock()
+	if b.Enabled || b.DisabledReason == "" {
+		b.mu.Unlock()
+		return
+	}
+	if b.reasonTip == nil {
+		b.reasonTip = popup.NewTooltip(b, b.DisabledReason)
+	} else {
+		b.reasonTip.SetText(b.DisabledReason)
+	}
+	tip := b.reasonTip
+	b.mu.Unlock()
+	tip.ShowAfterDelay()
+}
+
+func (b *Button) hideDisabledReason() {
+	b.mu.RLock()
+	tip := b.reasonTip
+	b.mu.RUnlock()
+	if tip != nil {
+		tip.Dismiss()
+	}
+}
+
+// Enable enables the button, implementing fyne.Disableable
+func (b *Button) Enable() {
+	b.SetEnabled(true)
+}
+
+// Disable disables the button, implementing fyne.Disableable
+func (b *Button) Disable() {
+	b.SetEnabled(false)
+}
+
+// Disabled returns whether the button is disabled, so focus traversal skips it
+func (b *Button) Disabled() bool {
+	return !b.IsEnabled()
+}
+
 // IsEnabled returns whether the button is enabled
 func (b *Button) IsEnabled() bool {
 	b.mu.RLock()
@@ -185,21 +311,29 @@ func (b *Button) CreateRenderer() fyne.WidgetRenderer {
 		iconImg.FillMode = canvas.ImageFillContain
 	}
 
-	return &buttonRenderer{
+	r := &buttonRenderer{
 		button:        b,
 		background:    background,
 		border:        border,
 		label:         label,
 		subtitleLabel: subtitleLabel,
 		icon:          iconImg,
+		focusRing:     core.NewFocusRing(),
 	}
+	r.ripple = canvas.NewRasterWithPixels(r.ripplePixel)
+	return r
 }
 
 // Tapped handles tap events
-func (b *Button) Tapped(_ *fyne.PointEvent) {
+func (b *Button) Tapped(ev *fyne.PointEvent) {
 	if !b.Enabled {
 		return
 	}
+	// Touch input has no MouseDown, so ripple from the tap instead
+	if b.RippleEffect && ev != nil && !b.isRippling() {
+		b.startRipple(ev.Position)
+	}
+	core.Feedback(core.FeedbackTap)
 	if b.OnTapped != nil {
 		b.OnTapped()
 	}
@@ -208,11 +342,51 @@ func (b *Button) Tapped(_ *fyne.PointEvent) {
 // TappedSecondary handles secondary tap events
 func (b *Button) TappedSecondary(_ *fyne.PointEvent) {}
 
+// AccessibilityDescription implements core.Accessible
+func (b *Button) AccessibilityDescription() core.AccessibilityDescription {
+	label := b.AccessibilityLabel
+	if label == "" {
+		label = b.Text
+	}
+	return core.AccessibilityDescription{
+		Role:     core.AccessibilityRoleButton,
+		Label:    label,
+		Disabled: !b.Enabled,
+	}
+}
+
+// FocusGained implements fyne.Focusable
+func (b *Button) FocusGained() {
+	b.mu.Lock()
+	b.focused = true
+	b.mu.Unlock()
+	b.Refresh()
+}
+
+// FocusLost implements fyne.Focusable
+func (b *Button) FocusLost() {
+	b.mu.Lock()
+	b.focused = false
+	b.mu.Unlock()
+	b.Refresh()
+}
+
+// TypedRune implements fyne.Focusable
+func (b *Button) TypedRune(rune) {}
+
+// TypedKey implements fyne.Focusable, tapping the button on Space or Return
+func (b *Button) TypedKey(key *fyne.KeyEvent) {
+	if core.IsActivationKey(key) {
+		b.Tapped(nil)
+	}
+}
+
 // MouseIn handles mouse enter
 func (b *Button) MouseIn(_ *desktop.MouseEvent) {
 	b.mu.Lock()
 	b.hovered = true
 	b.mu.Unlock()
+	b.showDisabledReason()
 	b.Refresh()
 }
 
@@ -224,6 +398,7 @@ func (b *Button) MouseOut() {
 	b.mu.Lock()
 	b.hovered = false
 	b.mu.Unlock()
+	b.hideDisabledReason()
 	b.Refresh()
 }
 
@@ -236,13 +411,17 @@ func (b *Button) Cursor() desktop.Cursor {
 }
 
 // MouseDown handles mouse button press - shows highlighted state
-func (b *Button) MouseDown(_ *desktop.MouseEvent) {
+func (b *Button) MouseDown(ev *desktop.MouseEvent) {
 	if !b.Enabled {
 		return
 	}
 	b.mu.Lock()
 	b.highlighted = true
 	b.mu.Unlock()
+	if b.RippleEffect && ev != nil {
+		b.startRipple(ev.Position)
+		return
+	}
 	b.Refresh()
 }
 
@@ -257,10 +436,12 @@ func (b *Button) MouseUp(_ *desktop.MouseEvent) {
 type buttonRenderer struct {
 	button        *Button
 	background    *canvas.Rectangle
+	ripple        *canvas.Raster
 	border        *canvas.Rectangle
 	label         *canvas.Text
 	subtitleLabel *canvas.Text
 	icon          *canvas.Image
+	focusRing     *canvas.Rectangle
 }
 
 func (r *buttonRenderer) Destroy() {}
@@ -272,6 +453,13 @@ func (r *buttonRenderer) Layout(size fyne.Size) {
 	r.border.Resize(size)
 	r.border.Move(fyne.NewPos(0, 0))
 
+	r.ripple.Resize(size)
+
+	r.button.mu.RLock()
+	focused := r.button.focused
+	r.button.mu.RUnlock()
+	core.UpdateFocusRing(r.focusRing, size, r.background.CornerRadius, focused)
+
 	insets := r.button.ContentEdgeInsets
 	contentArea := fyne.NewSize(
 		size.Width-insets.Left-insets.Right,
@@ -442,8 +630,11 @@ func (r *buttonRenderer) Refresh() {
 	hovered := r.button.hovered
 	enabled := r.button.Enabled
 	highlighted := r.button.highlighted
+	focused := r.button.focused
 	r.button.mu.RUnlock()
 
+	core.UpdateFocusRing(r.focusRing, r.button.Size(), cornerRadius, focused)
+
 	alpha := 1.0
 	if !enabled && r.button.AdjustsButtonWhenDisabled {
 		alpha = config.ButtonDisabledAlpha
@@ -457,6 +648,14 @@ func (r *buttonRenderer) Refresh() {
 		}
 	}
 
+	// Dim fill and border together with the title so every style reads as disabled
+	if !enabled && r.button.AdjustsButtonWhenDisabled {
+		r.background.FillColor = dimColor(r.background.FillColor, alpha)
+		if r.button.BorderWidth > 0 {
+			r.border.StrokeColor = dimColor(r.border.StrokeColor, alpha)
+		}
+	}
+
 	// Update label color
 	textColor := theme.ForegroundColor()
 	if r.button.AdjustsTitleTintColorAutomatically && r.button.TintColor != nil {
@@ -486,20 +685,92 @@ func (r *buttonRenderer) Refresh() {
 	}
 
 	r.background.Refresh()
+	if r.button.isRippling() {
+		r.ripple.Refresh()
+	}
 	r.border.Refresh()
 	r.label.Refresh()
 	r.subtitleLabel.Refresh()
 }
 
+// rippleColor returns the ripple tint
+func (r *buttonRenderer) rippleColor() color.Color {
+	if r.button.RippleColor != nil {
+		return r.button.RippleColor
+	}
+	if r.button.BackgroundColor != nil {
+		return core.BlendColors(r.button.BackgroundColor, color.White, 0.45)
+	}
+	if r.button.TintColor != nil {
+		return core.ColorWithAlpha(r.button.TintColor, 0.3)
+	}
+	return core.ColorWithAlpha(theme.ForegroundColor(), 0.3)
+}
+
+// ripplePixel draws the expanding, fading ripple clipped to the button's
+// rounded rect
+func (r *buttonRenderer) ripplePixel(x, y, w, h int) color.Color {
+	r.button.mu.RLock()
+	center := r.button.rippleCenter
+	progress := r.button.rippleProgress
+	r.button.mu.RUnlock()
+
+	size := r.button.Size()
+	if w == 0 || h == 0 || size.Width == 0 || size.Height == 0 {
+		return color.Transparent
+	}
+	px := (float32(x) + 0.5) * size.Width / float32(w)
+	py := (float32(y) + 0.5) * size.Height / float32(h)
+
+	// Clip to the rounded corners
+	radius := r.background.CornerRadius
+	cx := core.Clamp(px, radius, size.Width-radius)
+	cy := core.Clamp(py, radius, size.Height-radius)
+	if dx, dy := px-cx, py-cy; dx*dx+dy*dy > radius*radius {
+		return color.Transparent
+	}
+
+	// The ripple grows until it reaches the farthest corner
+	farX := math.Max(float64(center.X), float64(size.Width-center.X))
+	farY := math.Max(float64(center.Y), float64(size.Height-center.Y))
+	rippleRadius := math.Hypot(farX, farY) * progress
+	if math.Hypot(float64(px-center.X), float64(py-center.Y)) > rippleRadius {
+		return color.Transparent
+	}
+
+	return dimColor(r.rippleColor(), 1-progress)
+}
+
 func (r *buttonRenderer) Objects() []fyne.CanvasObject {
-	objects := []fyne.CanvasObject{r.background, r.border, r.label}
+	objects := []fyne.CanvasObject{r.background}
+	if r.button.isRippling() {
+		objects = append(objects, r.ripple)
+	}
+	objects = append(objects, r.border, r.label)
 	if r.button.Subtitle != "" {
 		objects = append(objects, r.subtitleLabel)
 	}
 	if r.icon != nil {
 		objects = append(objects, r.icon)
 	}
-	return objects
+	return append(objects, r.focusRing)
+}
+
+// dimColor scales the existing alpha of c by alpha
+func dimColor(c color.Color, alpha float64) color.Color {
+	if c == nil {
+		return c
+	}
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return c
+	}
+	return color.NRGBA{
+		R: uint8(r * 0xffff / a >> 8),
+		G: uint8(g * 0xffff / a >> 8),
+		B: uint8(b * 0xffff / a >> 8),
+		A: uint8(float64(a>>8) * alpha),
+	}
 }
 
 func max(a, b float32) float32 {
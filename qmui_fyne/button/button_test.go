@@ -3,11 +3,25 @@ package button
 import (
 	"image/color"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/test"
+
+	"github.com/paul-hammant/qmui_fyne/popup"
 )
 
+// waitFor polls cond until it holds or a second has passed, returning
+// whether it held
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for !cond() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return cond()
+}
+
 func TestButton_VisualRendering(t *testing.T) {
 	btn := NewButton("Test Button", func() {})
 
@@ -91,3 +105,112 @@ func TestNavigationButton_IconAndTitle(t *testing.T) {
 
 	w.Close()
 }
+
+func TestButton_DisabledDimsFillAndBorder(t *testing.T) {
+	fill := NewFillButton("Fill", color.RGBA{R: 0, G: 122, B: 255, A: 255}, func() {})
+	ghost := NewGhostButton("Ghost", color.RGBA{R: 255, G: 0, B: 0, A: 255}, func() {})
+	fill.SetEnabled(false)
+	ghost.SetEnabled(false)
+
+	fillRenderer := test.WidgetRenderer(fill.Button).(*buttonRenderer)
+	ghostRenderer := test.WidgetRenderer(ghost.Button).(*buttonRenderer)
+	fillRenderer.Refresh()
+	ghostRenderer.Refresh()
+
+	if _, _, _, a := fillRenderer.background.FillColor.RGBA(); a>>8 != 127 {
+		t.Errorf("disabled fill alpha = %d, want 127", a>>8)
+	}
+	if _, _, _, a := ghostRenderer.border.StrokeColor.RGBA(); a>>8 != 127 {
+		t.Errorf("disabled ghost border alpha = %d, want 127", a>>8)
+	}
+
+	// Refreshing again must not compound the dimming
+	ghostRenderer.Refresh()
+	if _, _, _, a := ghostRenderer.border.StrokeColor.RGBA(); a>>8 != 127 {
+		t.Errorf("ghost border alpha after second refresh = %d, want 127", a>>8)
+	}
+
+	ghost.SetEnabled(true)
+	if _, _, _, a := ghostRenderer.border.StrokeColor.RGBA(); a>>8 != 255 {
+		t.Errorf("enabled ghost border alpha = %d, want 255", a>>8)
+	}
+}
+
+func TestButton_DisabledReasonTooltip(t *testing.T) {
+	btn := NewButton("Save", func() {})
+	btn.DisabledReason = "Nothing to save"
+	w := test.NewWindow(btn)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 100))
+
+	// Ignored while enabled
+	btn.MouseIn(nil)
+	if btn.reasonTip != nil {
+		t.Error("disabled reason should not be scheduled while enabled")
+	}
+	btn.MouseOut()
+
+	btn.SetEnabled(false)
+	btn.reasonTip = popup.NewTooltip(btn, btn.DisabledReason)
+	btn.reasonTip.Delay = time.Millisecond
+	btn.MouseIn(nil)
+	if !waitFor(btn.IsShowingDisabledReason) {
+		t.Fatal("disabled reason should show on hover while disabled")
+	}
+
+	btn.SetEnabled(true)
+	if btn.IsShowingDisabledReason() {
+		t.Error("enabling the button should hide the disabled reason")
+	}
+}
+
+func TestFillButton_RippleEffect(t *testing.T) {
+	tapped := false
+	btn := NewFillButton("Ripple", color.RGBA{R: 0, G: 122, B: 255, A: 255}, func() {
+		tapped = true
+	})
+	w := test.NewWindow(btn)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 60))
+
+	renderer := test.WidgetRenderer(btn.Button).(*buttonRenderer)
+	countObjects := len(renderer.Objects())
+
+	// Off by default
+	btn.MouseDown(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(20, 20)}})
+	btn.MouseUp(nil)
+	if btn.isRippling() || len(renderer.Objects()) != countObjects {
+		t.Fatal("Ripple should be opt-in")
+	}
+
+	btn.RippleEffect = true
+	btn.MouseDown(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(20, 20)}})
+	if !btn.isRippling() || len(renderer.Objects()) != countObjects+1 {
+		t.Fatal("MouseDown should start a ripple")
+	}
+
+	// Let the ripple spread a third of the way before sampling it
+	waitFor(func() bool {
+		btn.mu.RLock()
+		defer btn.mu.RUnlock()
+		return btn.rippleProgress >= 1.0/3
+	})
+	size := btn.Size()
+	w2, h2 := int(size.Width), int(size.Height)
+	if _, _, _, a := renderer.ripplePixel(20, 20, w2, h2).RGBA(); a == 0 {
+		t.Error("Ripple should cover the press point")
+	}
+	if _, _, _, a := renderer.ripplePixel(0, 0, w2, h2).RGBA(); a != 0 {
+		t.Error("Ripple should be clipped to the rounded corners")
+	}
+
+	btn.MouseUp(nil)
+	btn.Tapped(&fyne.PointEvent{Position: fyne.NewPos(20, 20)})
+	if !tapped {
+		t.Error("Ripple should not interfere with OnTapped")
+	}
+
+	if !waitFor(func() bool { return !btn.isRippling() }) {
+		t.Error("Ripple should finish after its duration")
+	}
+}
@@ -15,6 +15,30 @@ import (
 	"github.com/paul-hammant/qmui_fyne/core"
 )
 
+// CheckboxShape is the outline of the checkbox box
+type CheckboxShape int
+
+const (
+	// CheckboxShapeCircle is the iOS-style round checkbox
+	CheckboxShapeCircle CheckboxShape = iota
+	// CheckboxShapeSquare has sharp corners
+	CheckboxShapeSquare
+	// CheckboxShapeRoundedSquare has slightly rounded corners
+	CheckboxShapeRoundedSquare
+)
+
+// cornerRadius returns the corner radius that draws shape at size
+func (shape CheckboxShape) cornerRadius(size fyne.Size) float32 {
+	switch shape {
+	case CheckboxShapeSquare:
+		return 0
+	case CheckboxShapeRoundedSquare:
+		return min(size.Width, size.Height) * 0.2
+	default:
+		return min(size.Width, size.Height) / 2
+	}
+}
+
 // Checkbox is a circular checkbox control with three states:
 // - Unchecked (Selected = false, Indeterminate = false)
 // - Checked (Selected = true, Indeterminate = false)
@@ -30,6 +54,8 @@ type Checkbox struct {
 	// Styling
 	TintColor     color.Color
 	CheckboxSize  fyne.Size
+	Shape         CheckboxShape
+	CheckIcon     fyne.Resource // Drawn instead of the tick when checked
 	NormalImage   fyne.Resource
 	SelectedImage fyne.Resource
 	IndeterminateImage fyne.Resource
@@ -44,8 +70,12 @@ type Checkbox struct {
 	// Callbacks
 	OnChanged func(selected bool)
 
+	// AccessibilityLabel is announced by screen readers, defaulting to Text
+	AccessibilityLabel string
+
 	mu      sync.RWMutex
 	hovered bool
+	focused bool
 }
 
 // NewCheckbox creates a new checkbox
@@ -118,17 +148,23 @@ func (c *Checkbox) Toggle() {
 func (c *Checkbox) CreateRenderer() fyne.WidgetRenderer {
 	c.ExtendBaseWidget(c)
 
-	// Create the checkbox visual - use circle for iOS-style
-	circle := canvas.NewCircle(color.Transparent)
-	circle.StrokeWidth = 2
+	// The box is a rounded rectangle so one object draws every shape
+	box := canvas.NewRectangle(color.Transparent)
+	box.StrokeWidth = 2
+
+	checkIcon := canvas.NewImageFromResource(nil)
+	checkIcon.FillMode = canvas.ImageFillContain
+	checkIcon.Hide()
 
 	label := canvas.NewText(c.Text, c.TextColor)
 	label.TextSize = c.TextSize
 
 	return &checkboxRenderer{
-		checkbox: c,
-		circle:   circle,
-		label:    label,
+		checkbox:  c,
+		box:       box,
+		checkIcon: checkIcon,
+		label:     label,
+		focusRing: core.NewFocusRing(),
 	}
 }
 
@@ -138,11 +174,94 @@ func (c *Checkbox) Tapped(_ *fyne.PointEvent) {
 		return
 	}
 	c.Toggle()
+
+	c.mu.RLock()
+	selected := c.Selected
+	c.mu.RUnlock()
+	if selected {
+		core.Feedback(core.FeedbackToggleOn)
+	} else {
+		core.Feedback(core.FeedbackToggleOff)
+	}
 }
 
 // TappedSecondary handles secondary tap
 func (c *Checkbox) TappedSecondary(_ *fyne.PointEvent) {}
 
+// Enable enables the checkbox, implementing fyne.Disableable
+func (c *Checkbox) Enable() {
+	c.mu.Lock()
+	c.Enabled = true
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+// Disable disables the checkbox, implementing fyne.Disableable
+func (c *Checkbox) Disable() {
+	c.mu.Lock()
+	c.Enabled = false
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+// Disabled returns whether the checkbox is disabled, so focus traversal
+// skips it
+func (c *Checkbox) Disabled() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return !c.Enabled
+}
+
+// FocusGained implements fyne.Focusable
+func (c *Checkbox) FocusGained() {
+	c.mu.Lock()
+	c.focused = true
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+// FocusLost implements fyne.Focusable
+func (c *Checkbox) FocusLost() {
+	c.mu.Lock()
+	c.focused = false
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+// TypedRune implements fyne.Focusable
+func (c *Checkbox) TypedRune(rune) {}
+
+// TypedKey implements fyne.Focusable, toggling the checkbox on Space or
+// Return
+func (c *Checkbox) TypedKey(key *fyne.KeyEvent) {
+	if core.IsActivationKey(key) {
+		c.Tapped(nil)
+	}
+}
+
+// AccessibilityDescription implements core.Accessible
+func (c *Checkbox) AccessibilityDescription() core.AccessibilityDescription {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	label := c.AccessibilityLabel
+	if label == "" {
+		label = c.Text
+	}
+	value := "unchecked"
+	if c.Indeterminate {
+		value = "mixed"
+	} else if c.Selected {
+		value = "checked"
+	}
+	return core.AccessibilityDescription{
+		Role:     core.AccessibilityRoleCheckbox,
+		Label:    label,
+		Value:    value,
+		Disabled: !c.Enabled,
+	}
+}
+
 // MouseIn handles mouse enter
 func (c *Checkbox) MouseIn(_ *desktop.MouseEvent) {
 	c.mu.Lock()
@@ -172,27 +291,34 @@ func (c *Checkbox) Cursor() desktop.Cursor {
 
 type checkboxRenderer struct {
 	checkbox *Checkbox
-	circle   *canvas.Circle
-	// Checkmark drawn with two lines (tick shape)
+	box      *canvas.Rectangle
+	// Checkmark drawn with two lines (tick shape), or CheckIcon when set
 	checkLine1 *canvas.Line
 	checkLine2 *canvas.Line
+	checkIcon  *canvas.Image
 	// Indeterminate drawn with horizontal line
 	indeterminateLine *canvas.Line
 	label             *canvas.Text
+	focusRing         *canvas.Rectangle
 }
 
 func (r *checkboxRenderer) Destroy() {}
 
 func (r *checkboxRenderer) Layout(size fyne.Size) {
 	checkSize := r.checkbox.CheckboxSize
-	centerY := (size.Height - checkSize.Height) / 2
+	origin := r.checkbox.boxOrigin(size)
+	centerY := origin.Y
 
-	// Position circle
-	r.circle.Resize(checkSize)
-	r.circle.Move(fyne.NewPos(0, centerY))
+	// Position box
+	r.box.Resize(checkSize)
+	r.box.Move(origin)
+
+	iconSize := fyne.NewSize(checkSize.Width*0.7, checkSize.Height*0.7)
+	r.checkIcon.Resize(iconSize)
+	r.checkIcon.Move(origin.AddXY((checkSize.Width-iconSize.Width)/2, (checkSize.Height-iconSize.Height)/2))
 
 	// Calculate checkmark positions (tick shape: short line down-right, long line up-right)
-	cx := checkSize.Width / 2
+	cx := origin.X + checkSize.Width/2
 	cy := centerY + checkSize.Height/2
 
 	// Checkmark proportions for a nice tick
@@ -219,9 +345,14 @@ func (r *checkboxRenderer) Layout(size fyne.Size) {
 	r.checkLine2.Position2 = fyne.NewPos(endX, endY)
 	r.checkLine2.StrokeWidth = 2
 
-	// Indeterminate line (horizontal bar in center)
-	r.indeterminateLine.Position1 = fyne.NewPos(cx-checkSize.Width*0.25, cy)
-	r.indeterminateLine.Position2 = fyne.NewPos(cx+checkSize.Width*0.25, cy)
+	// Indeterminate line (horizontal bar in center), reaching further into
+	// the corners of a square box
+	dash := checkSize.Width * 0.25
+	if r.checkbox.Shape != CheckboxShapeCircle {
+		dash = checkSize.Width * 0.3
+	}
+	r.indeterminateLine.Position1 = fyne.NewPos(cx-dash, cy)
+	r.indeterminateLine.Position2 = fyne.NewPos(cx+dash, cy)
 	r.indeterminateLine.StrokeWidth = 2
 
 	// Position label
@@ -229,6 +360,35 @@ func (r *checkboxRenderer) Layout(size fyne.Size) {
 		labelX := checkSize.Width + r.checkbox.SpacingBetweenCheckboxAndText
 		r.label.Move(fyne.NewPos(labelX, (size.Height-r.label.MinSize().Height)/2))
 	}
+
+	r.checkbox.mu.RLock()
+	focused := r.checkbox.focused
+	r.checkbox.mu.RUnlock()
+	r.updateFocusRing(size, focused)
+}
+
+// boxOrigin returns where the box is drawn in a checkbox of size. Without
+// a label the box sits in the middle of the padded touch target.
+func (c *Checkbox) boxOrigin(size fyne.Size) fyne.Position {
+	x := float32(0)
+	if c.Text == "" {
+		target := core.TouchTargetSize(c.CheckboxSize)
+		x = (fyne.Min(size.Width, target.Width) - c.CheckboxSize.Width) / 2
+		x = fyne.Max(x, 0)
+	}
+	return fyne.NewPos(x, (size.Height-c.CheckboxSize.Height)/2)
+}
+
+// updateFocusRing rings the box like its shape when there is no label, and
+// the whole checkbox as a rounded rect otherwise
+func (r *checkboxRenderer) updateFocusRing(size fyne.Size, focused bool) {
+	if r.checkbox.Text != "" {
+		core.UpdateFocusRing(r.focusRing, size, 6, focused)
+		return
+	}
+	checkSize := r.checkbox.CheckboxSize
+	core.UpdateFocusRing(r.focusRing, checkSize, r.checkbox.Shape.cornerRadius(checkSize), focused)
+	r.focusRing.Move(r.focusRing.Position().Add(r.checkbox.boxOrigin(size)))
 }
 
 func (r *checkboxRenderer) MinSize() fyne.Size {
@@ -244,7 +404,8 @@ func (r *checkboxRenderer) MinSize() fyne.Size {
 		}
 	}
 
-	return fyne.NewSize(width, height)
+	// Pad the hit area, not the box, to the minimum touch target
+	return core.TouchTargetSize(fyne.NewSize(width, height))
 }
 
 func (r *checkboxRenderer) Refresh() {
@@ -253,6 +414,7 @@ func (r *checkboxRenderer) Refresh() {
 	indeterminate := r.checkbox.Indeterminate
 	enabled := r.checkbox.Enabled
 	hovered := r.checkbox.hovered
+	focused := r.checkbox.focused
 	r.checkbox.mu.RUnlock()
 
 	tintColor := r.checkbox.TintColor
@@ -265,28 +427,36 @@ func (r *checkboxRenderer) Refresh() {
 		r.indeterminateLine = canvas.NewLine(color.White)
 	}
 
-	// Update circle appearance - iOS style: stroke only for normal, filled for selected
+	// Update box appearance - iOS style: stroke only for normal, filled for selected
 	if !enabled {
-		r.circle.StrokeColor = core.ColorWithAlpha(tintColor, config.ControlDisabledAlpha)
-		r.circle.FillColor = color.Transparent
+		r.box.StrokeColor = core.ColorWithAlpha(tintColor, config.ControlDisabledAlpha)
+		r.box.FillColor = color.Transparent
 	} else if selected || indeterminate {
-		// Filled circle when selected/indeterminate
-		r.circle.StrokeColor = tintColor
-		r.circle.FillColor = tintColor
+		// Filled box when selected/indeterminate
+		r.box.StrokeColor = tintColor
+		r.box.FillColor = tintColor
 	} else {
 		// Outline only when not selected (iOS style)
-		r.circle.StrokeColor = tintColor
-		r.circle.FillColor = color.Transparent
+		r.box.StrokeColor = tintColor
+		r.box.FillColor = color.Transparent
 	}
 
 	if hovered && enabled && !selected && !indeterminate {
-		r.circle.StrokeColor = core.ColorWithAlpha(tintColor, 0.7)
+		r.box.StrokeColor = core.ColorWithAlpha(tintColor, 0.7)
 	}
 
-	r.circle.StrokeWidth = 2
+	r.box.StrokeWidth = 2
+	r.box.CornerRadius = r.checkbox.Shape.cornerRadius(r.checkbox.CheckboxSize)
 
-	// Show/hide checkmark lines
-	if selected && !indeterminate {
+	// Show the check icon, or the checkmark lines when there is none
+	checkIcon := r.checkbox.CheckIcon
+	r.checkIcon.Resource = checkIcon
+	if selected && !indeterminate && checkIcon != nil {
+		r.checkIcon.Show()
+	} else {
+		r.checkIcon.Hide()
+	}
+	if selected && !indeterminate && checkIcon == nil {
 		r.checkLine1.StrokeColor = color.White
 		r.checkLine2.StrokeColor = color.White
 		r.checkLine1.Show()
@@ -312,13 +482,15 @@ func (r *checkboxRenderer) Refresh() {
 		r.label.Color = core.ColorWithAlpha(r.checkbox.TextColor, config.ControlDisabledAlpha)
 	}
 
-	r.circle.Refresh()
+	r.box.Refresh()
+	r.checkIcon.Refresh()
 	if r.checkLine1 != nil {
 		r.checkLine1.Refresh()
 		r.checkLine2.Refresh()
 		r.indeterminateLine.Refresh()
 	}
 	r.label.Refresh()
+	r.updateFocusRing(r.checkbox.Size(), focused)
 }
 
 func (r *checkboxRenderer) Objects() []fyne.CanvasObject {
@@ -328,11 +500,11 @@ func (r *checkboxRenderer) Objects() []fyne.CanvasObject {
 		r.checkLine2 = canvas.NewLine(color.White)
 		r.indeterminateLine = canvas.NewLine(color.White)
 	}
-	objects := []fyne.CanvasObject{r.circle, r.checkLine1, r.checkLine2, r.indeterminateLine}
+	objects := []fyne.CanvasObject{r.box, r.checkLine1, r.checkLine2, r.indeterminateLine, r.checkIcon}
 	if r.checkbox.Text != "" {
 		objects = append(objects, r.label)
 	}
-	return objects
+	return append(objects, r.focusRing)
 }
 
 // CheckboxGroup manages a group of checkboxes
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
 )
 
 func TestCheckbox_VisualStateToggle(t *testing.T) {
@@ -98,3 +100,55 @@ func TestCheckbox_MinSizeReasonable(t *testing.T) {
 	t.Logf("Checkbox min size: %v", minSize)
 	w.Close()
 }
+
+func TestCheckbox_ShapeAndCheckIcon(t *testing.T) {
+	cb := NewCheckbox(nil)
+	renderer := test.WidgetRenderer(cb)
+	box := renderer.Objects()[0].(*canvas.Rectangle)
+
+	shapes := map[CheckboxShape]float32{
+		CheckboxShapeCircle:        8,
+		CheckboxShapeSquare:        0,
+		CheckboxShapeRoundedSquare: 3.2,
+	}
+	for shape, radius := range shapes {
+		cb.Shape = shape
+		renderer.Refresh()
+		if box.CornerRadius != radius {
+			t.Errorf("Shape %d should round the 16px box by %v, got %v", shape, radius, box.CornerRadius)
+		}
+	}
+
+	cb.CheckIcon = theme.ConfirmIcon()
+	cb.SetSelected(true)
+	renderer.Refresh()
+	objects := renderer.Objects()
+	if objects[1].Visible() || objects[2].Visible() {
+		t.Error("The tick lines should be hidden when CheckIcon is set")
+	}
+	if icon := objects[4].(*canvas.Image); !icon.Visible() || icon.Resource != theme.ConfirmIcon() {
+		t.Error("CheckIcon should be shown when checked")
+	}
+}
+
+func TestCheckbox_MinimumTouchTarget(t *testing.T) {
+	cb := NewCheckbox(nil)
+	w := test.NewWindow(cb)
+	defer w.Close()
+	w.SetPadded(false)
+
+	renderer := test.WidgetRenderer(cb)
+	if min := renderer.MinSize(); min.Width < 44 || min.Height < 44 {
+		t.Errorf("Checkbox hit area should be padded to 44x44, got %v", min)
+	}
+
+	w.Resize(fyne.NewSize(44, 44))
+	box := renderer.Objects()[0].(*canvas.Rectangle)
+	if box.Size() != cb.CheckboxSize {
+		t.Errorf("Box should keep its visual size %v, got %v", cb.CheckboxSize, box.Size())
+	}
+	want := fyne.NewPos((44-cb.CheckboxSize.Width)/2, (44-cb.CheckboxSize.Height)/2)
+	if box.Position() != want {
+		t.Errorf("Box should sit in the middle of the hit area at %v, got %v", want, box.Position())
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"image/color"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -399,9 +400,15 @@ func createSwitchDemo() fyne.CanvasObject {
 }
 
 func createSegmentedDemo() fyne.CanvasObject {
-	sc := segmented.NewSegmentedControl([]string{"Day", "Week", "Month"}, func(i int) {
-		toast.ShowMessage(mainWindow, fmt.Sprintf("Selected: %d", i))
+	sc := segmented.NewSegmentedContentSwitcher([]string{"Day", "Week", "Month"}, []fyne.CanvasObject{
+		widget.NewLabel("Today's schedule"),
+		widget.NewLabel("This week's schedule"),
+		widget.NewLabel("This month's schedule"),
 	})
+	sc.CrossFade = true
+	sc.OnSelectionChanged = func(i int) {
+		toast.ShowMessage(mainWindow, fmt.Sprintf("Selected: %d", i))
+	}
 	return sc
 }
 
@@ -460,11 +467,25 @@ func createProgressTab() fyne.CanvasObject {
 		lin.SetProgress(0)
 	})
 
+	countdown := progress.NewCountdown(30 * time.Second)
+	countdown.TintColor = primaryColor()
+	var sendBtn *widget.Button
+	sendBtn = widget.NewButton("Send Code", func() {
+		countdown.Reset(30 * time.Second)
+		countdown.Start()
+		sendBtn.Disable()
+	})
+	countdown.OnComplete = func() {
+		sendBtn.SetText("Resend Code")
+		sendBtn.Enable()
+	}
+
 	return container.NewScroll(container.NewVBox(
 		createComponentCard("progress.PieProgressView", "Pie chart style", pie),
 		createComponentCard("progress.CircularProgressView", "Ring with percentage", circ),
 		createComponentCard("progress.LinearProgressView", "Horizontal bar", lin),
 		createSectionCard("Controls", container.NewHBox(animateBtn, resetBtn)),
+		createComponentCard("progress.Countdown", "Verification code expires in 30s", container.NewHBox(countdown, container.NewCenter(sendBtn))),
 	))
 }
 
@@ -674,6 +695,9 @@ func createTableDemo() fyne.CanvasObject {
 		table.NewTableCellWithTextAndDetail("Profile", "View"),
 		table.NewTableCellWithTextAndDetail("Settings", "Configure"),
 	}
+	for _, cell := range section1.Cells {
+		cell.AccessoryType = table.CellAccessoryDisclosureIndicator
+	}
 
 	section2 := table.NewTableSection("Preferences")
 	section2.Cells = []*table.TableCell{
@@ -682,7 +706,24 @@ func createTableDemo() fyne.CanvasObject {
 	}
 
 	tv.Sections = []*table.TableSection{section1, section2}
-	return tv
+	tv.OnCellSelected = func(section, row int) {
+		cell := tv.Sections[section].Cells[row]
+		toast.ShowMessage(mainWindow, fmt.Sprintf("Selected %s", cell.Text))
+	}
+
+	searchBar := search.NewSearchBarWithPlaceholder("Filter settings")
+	searchBar.OnTextChanged = func(text string) {
+		if text == "" {
+			tv.ClearFilter()
+			return
+		}
+		query := strings.ToLower(text)
+		tv.SetFilter(func(cell *table.TableCell) bool {
+			return strings.Contains(strings.ToLower(cell.Text), query)
+		})
+	}
+
+	return container.NewVBox(searchBar, tv)
 }
 
 // ═══════════════════════════════════════════════════════════════
@@ -61,12 +61,18 @@ type PagingLayout struct {
 	AnimationDuration time.Duration
 	AnimationEasing   animation.EasingFunction
 
-	// Callbacks
+	// Callbacks. OnPageChanged is called as soon as the page changes;
+	// OnPageSettled once the layout has finished moving to it.
 	OnPageChanged func(page int)
+	OnPageSettled func(page int)
 	OnItemTapped  func(index int)
 
 	// State
 	mu           sync.RWMutex
+	settle       *animation.PropertyAnimation
+	pageCount    int
+	pageBuilder  func(index int) fyne.CanvasObject
+	builderGen   int
 	offsetX      float32
 	isDragging   bool
 	lastDragPos  fyne.Position
@@ -112,6 +118,7 @@ func NewPagingLayoutWithItems(items []fyne.CanvasObject) *PagingLayout {
 func (pl *PagingLayout) SetItems(items []fyne.CanvasObject) {
 	pl.mu.Lock()
 	pl.Items = items
+	pl.pageBuilder = nil
 	if pl.CurrentPage >= len(items) {
 		pl.CurrentPage = len(items) - 1
 	}
@@ -126,6 +133,7 @@ func (pl *PagingLayout) SetItems(items []fyne.CanvasObject) {
 func (pl *PagingLayout) AddItem(item fyne.CanvasObject) {
 	pl.mu.Lock()
 	pl.Items = append(pl.Items, item)
+	pl.pageBuilder = nil
 	pl.mu.Unlock()
 	pl.Refresh()
 }
@@ -135,16 +143,45 @@ func (pl *PagingLayout) AddPage(page fyne.CanvasObject) {
 	pl.AddItem(page)
 }
 
+// SetPageBuilder makes the layout create its count pages lazily: builder
+// is called for a page when it comes near the viewport, and the page is
+// released again once it is far away. Items is ignored until SetItems or
+// AddItem is called.
+func (pl *PagingLayout) SetPageBuilder(count int, builder func(index int) fyne.CanvasObject) {
+	pl.mu.Lock()
+	pl.Items = nil
+	pl.pageCount = count
+	pl.pageBuilder = builder
+	pl.builderGen++
+	if pl.CurrentPage >= count {
+		pl.CurrentPage = count - 1
+	}
+	if pl.CurrentPage < 0 {
+		pl.CurrentPage = 0
+	}
+	pl.mu.Unlock()
+	pl.Refresh()
+}
+
+// itemCount returns the number of pages, built or not. Callers hold mu.
+func (pl *PagingLayout) itemCount() int {
+	if pl.pageBuilder != nil {
+		return pl.pageCount
+	}
+	return len(pl.Items)
+}
+
 // SetCurrentPage sets the current page immediately without animation
 func (pl *PagingLayout) SetCurrentPage(page int) {
 	pl.mu.Lock()
-	if page < 0 || page >= len(pl.Items) {
+	if page < 0 || page >= pl.itemCount() {
 		pl.mu.Unlock()
 		return
 	}
 	oldPage := pl.CurrentPage
 	pl.CurrentPage = page
 	pl.offsetX = pl.calculateOffsetForPage(page)
+	wasSettling := pl.stopSettleLocked()
 	pl.mu.Unlock()
 
 	pl.Refresh()
@@ -152,6 +189,9 @@ func (pl *PagingLayout) SetCurrentPage(page int) {
 	if page != oldPage && pl.OnPageChanged != nil {
 		pl.OnPageChanged(page)
 	}
+	if (page != oldPage || wasSettling) && pl.OnPageSettled != nil {
+		pl.OnPageSettled(page)
+	}
 }
 
 // RemoveItem removes an item at index
@@ -172,7 +212,7 @@ func (pl *PagingLayout) RemoveItem(index int) {
 // GoToPage animates to a specific page
 func (pl *PagingLayout) GoToPage(page int) {
 	pl.mu.Lock()
-	if page < 0 || page >= len(pl.Items) {
+	if page < 0 || page >= pl.itemCount() {
 		pl.mu.Unlock()
 		return
 	}
@@ -192,7 +232,7 @@ func (pl *PagingLayout) GoToPage(page int) {
 func (pl *PagingLayout) NextPage() {
 	pl.mu.RLock()
 	current := pl.CurrentPage
-	count := len(pl.Items)
+	count := pl.itemCount()
 	pl.mu.RUnlock()
 
 	if current < count-1 {
@@ -215,14 +255,34 @@ func (pl *PagingLayout) PreviousPage() {
 func (pl *PagingLayout) GetPageCount() int {
 	pl.mu.RLock()
 	defer pl.mu.RUnlock()
-	return len(pl.Items)
+	return pl.itemCount()
+}
+
+// IsSettling returns whether the layout is still moving to the current page
+func (pl *PagingLayout) IsSettling() bool {
+	pl.mu.RLock()
+	defer pl.mu.RUnlock()
+	return pl.settle != nil
+}
+
+// stopSettleLocked stops moving to the current page, returning whether the
+// layout was. Callers hold mu.
+func (pl *PagingLayout) stopSettleLocked() bool {
+	if pl.settle == nil {
+		return false
+	}
+	pl.settle.Stop()
+	pl.settle = nil
+	return true
 }
 
 func (pl *PagingLayout) animateToPage(page int) {
+	pl.mu.Lock()
+	pl.stopSettleLocked()
 	targetOffset := pl.calculateOffsetForPage(page)
 	currentOffset := pl.offsetX
 
-	animation.NewPropertyAnimation(
+	settle := animation.NewPropertyAnimation(
 		float64(currentOffset),
 		float64(targetOffset),
 		pl.AnimationDuration,
@@ -235,7 +295,28 @@ func (pl *PagingLayout) animateToPage(page int) {
 				pl.Refresh()
 			})
 		},
-	).Start()
+	)
+	settle.OnComplete = func() {
+		pl.mu.Lock()
+		if pl.settle != settle {
+			// Superseded by another page change or a drag
+			pl.mu.Unlock()
+			return
+		}
+		pl.settle = nil
+		page := pl.CurrentPage
+		pl.mu.Unlock()
+
+		if pl.OnPageSettled != nil {
+			fyne.Do(func() {
+				pl.OnPageSettled(page)
+			})
+		}
+	}
+	pl.settle = settle
+	pl.mu.Unlock()
+
+	settle.Start()
 }
 
 func (pl *PagingLayout) calculateOffsetForPage(page int) float32 {
@@ -247,6 +328,7 @@ func (pl *PagingLayout) calculateOffsetForPage(page int) float32 {
 // Dragged implements fyne.Draggable
 func (pl *PagingLayout) Dragged(e *fyne.DragEvent) {
 	pl.mu.Lock()
+	pl.stopSettleLocked()
 	pl.isDragging = true
 	pl.offsetX -= e.Dragged.DX
 	pl.dragVelocity = e.Dragged.DX
@@ -281,7 +363,7 @@ func (pl *PagingLayout) DragEnd() {
 
 	// Clamp to valid range
 	pl.mu.RLock()
-	itemCount := len(pl.Items)
+	itemCount := pl.itemCount()
 	pl.mu.RUnlock()
 
 	if targetPage < 0 {
@@ -321,8 +403,18 @@ type pagingLayoutRenderer struct {
 	background    *canvas.Rectangle
 	itemViews     []*pagingItemView
 	pageIndicators []*canvas.Circle
+
+	// lazy is set while pages come from a page builder, with the built
+	// pages by index
+	lazy       bool
+	builderGen int
+	lazyViews  map[int]*pagingItemView
 }
 
+// lazyPageMargin is how many pages beyond the viewport a page builder's
+// pages are kept built
+const lazyPageMargin = 1
+
 func (r *pagingLayoutRenderer) Destroy() {}
 
 func (r *pagingLayoutRenderer) Layout(size fyne.Size) {
@@ -341,10 +433,22 @@ func (r *pagingLayoutRenderer) rebuildItems(size fyne.Size) {
 	minScale := r.layout.MinimumScale
 	maxScale := r.layout.MaximumScale
 	currentPage := r.layout.CurrentPage
+	builder := r.layout.pageBuilder
+	builderGen := r.layout.builderGen
+	count := r.layout.pageCount
 	r.layout.mu.RUnlock()
 
-	// Ensure we have the right number of item views
-	if len(r.itemViews) != len(items) {
+	if builder != nil {
+		if !r.lazy || r.builderGen != builderGen {
+			r.lazy = true
+			r.builderGen = builderGen
+			r.lazyViews = make(map[int]*pagingItemView)
+		}
+		r.syncLazyViews(size, builder, count, offsetX, itemSize.Width+spacing)
+	} else if r.lazy || len(r.itemViews) != len(items) {
+		// Ensure we have the right number of item views
+		r.lazy = false
+		r.lazyViews = nil
 		r.itemViews = make([]*pagingItemView, len(items))
 		for i, item := range items {
 			iv := &pagingItemView{
@@ -361,9 +465,9 @@ func (r *pagingLayoutRenderer) rebuildItems(size fyne.Size) {
 	centerX := size.Width / 2
 
 	// Position each item
-	for i, iv := range r.itemViews {
+	for _, iv := range r.itemViews {
 		// Calculate item position
-		itemX := float32(i)*(itemSize.Width+spacing) - offsetX + (size.Width-itemSize.Width)/2
+		itemX := float32(iv.index)*(itemSize.Width+spacing) - offsetX + (size.Width-itemSize.Width)/2
 		itemY := (size.Height - itemSize.Height) / 2
 
 		// Apply scaling based on style
@@ -402,10 +506,47 @@ func (r *pagingLayoutRenderer) rebuildItems(size fyne.Size) {
 	_ = currentPage // avoid unused warning
 }
 
+// syncLazyViews builds the pages near the viewport with builder and
+// releases the others, leaving itemViews holding the built pages in order
+func (r *pagingLayoutRenderer) syncLazyViews(size fyne.Size, builder func(int) fyne.CanvasObject, count int, offsetX, pageWidth float32) {
+	first, last := 0, count-1
+	if pageWidth > 0 {
+		first = int(math.Floor(float64((offsetX-size.Width/2)/pageWidth))) - lazyPageMargin
+		last = int(math.Ceil(float64((offsetX+size.Width/2)/pageWidth))) + lazyPageMargin
+	}
+	if first < 0 {
+		first = 0
+	}
+	if last > count-1 {
+		last = count - 1
+	}
+
+	for index := range r.lazyViews {
+		if index < first || index > last {
+			delete(r.lazyViews, index)
+		}
+	}
+
+	r.itemViews = r.itemViews[:0]
+	for index := first; index <= last; index++ {
+		iv := r.lazyViews[index]
+		if iv == nil {
+			iv = &pagingItemView{
+				layout:  r.layout,
+				index:   index,
+				content: builder(index),
+			}
+			iv.ExtendBaseWidget(iv)
+			r.lazyViews[index] = iv
+		}
+		r.itemViews = append(r.itemViews, iv)
+	}
+}
+
 func (r *pagingLayoutRenderer) buildPageIndicators(size fyne.Size) {
 	r.layout.mu.RLock()
 	enabled := r.layout.PageIndicatorEnabled
-	itemCount := len(r.layout.Items)
+	itemCount := r.layout.itemCount()
 	currentPage := r.layout.CurrentPage
 	indicatorSize := r.layout.PageIndicatorSize
 	indicatorSpacing := r.layout.PageIndicatorSpacing
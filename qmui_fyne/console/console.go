@@ -3,17 +3,40 @@
 package console
 
 import (
+	"fmt"
 	"image/color"
+	"io"
+	"strings"
 	"sync"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	fynedialog "fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/paul-hammant/qmui_fyne/core"
 	"github.com/paul-hammant/qmui_fyne/log"
 )
 
+// defaultMaxLines is how many lines a console keeps before dropping the oldest
+const defaultMaxLines = 5000
+
+// logLine is a console message with the number of times it was logged in a row
+type logLine struct {
+	text  string
+	count int
+}
+
+// String shows repeats of the message as a "(×N)" suffix
+func (l logLine) String() string {
+	if l.count > 1 {
+		return fmt.Sprintf("%s (×%d)", l.text, l.count)
+	}
+	return l.text
+}
+
 // Console is an in-app debug console
 type Console struct {
 	widget.BaseWidget
@@ -22,10 +45,15 @@ type Console struct {
 	BackgroundColor color.Color
 	TextColor       color.Color
 	FontSize        float32
-	MaxLines        int
+	MaxLines        int // Oldest lines are dropped beyond this; set with SetMaxLines
+
+	// Behavior
+	ExportIgnoresFilter bool // Copy and export include lines hidden by the filter
 
 	// State
-	logs     []string
+	logs     []logLine // Ring buffer whose oldest line is at head once full
+	head     int
+	filter   string
 	visible  bool
 	window   fyne.Window
 	popup    *widget.PopUp
@@ -52,8 +80,8 @@ func NewConsole() *Console {
 		BackgroundColor: color.RGBA{R: 0, G: 0, B: 0, A: 220},
 		TextColor:       color.RGBA{R: 0, G: 255, B: 0, A: 255},
 		FontSize:        12,
-		MaxLines:        100,
-		logs:            make([]string, 0),
+		MaxLines:        defaultMaxLines,
+		logs:            make([]logLine, 0),
 	}
 	c.ExtendBaseWidget(c)
 
@@ -65,25 +93,186 @@ func NewConsole() *Console {
 	return c
 }
 
-// Log adds a log message
+// Log adds a log message. A message identical to the previous one is counted
+// on that line instead of being added again.
 func (c *Console) Log(message string) {
 	c.mu.Lock()
-	c.logs = append(c.logs, message)
-	if len(c.logs) > c.MaxLines {
-		c.logs = c.logs[len(c.logs)-c.MaxLines:]
+	if len(c.logs) > 0 {
+		last := (c.head + len(c.logs) - 1) % len(c.logs)
+		if c.logs[last].text == message {
+			c.logs[last].count++
+			c.mu.Unlock()
+			c.Refresh()
+			return
+		}
+	}
+
+	maxLines := c.maxLinesLocked()
+	if len(c.logs) > maxLines {
+		c.setCapacityLocked(maxLines)
+	}
+	line := logLine{text: message, count: 1}
+	if len(c.logs) < maxLines {
+		c.logs = append(c.logs, line)
+	} else {
+		// Overwrite the oldest line
+		c.logs[c.head] = line
+		c.head = (c.head + 1) % len(c.logs)
 	}
 	c.mu.Unlock()
 	c.Refresh()
 }
 
-// Clear clears all logs
+// SetMaxLines sets how many lines the console keeps, dropping the oldest
+// lines beyond n. Values below 1 keep a single line.
+func (c *Console) SetMaxLines(n int) {
+	c.mu.Lock()
+	c.MaxLines = n
+	c.setCapacityLocked(c.maxLinesLocked())
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+func (c *Console) maxLinesLocked() int {
+	if c.MaxLines < 1 {
+		return 1
+	}
+	return c.MaxLines
+}
+
+// setCapacityLocked unrolls the ring buffer, keeping its newest n lines
+func (c *Console) setCapacityLocked(n int) {
+	lines := c.orderedLocked()
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	c.logs = lines
+	c.head = 0
+}
+
+// orderedLocked returns the lines oldest first
+func (c *Console) orderedLocked() []logLine {
+	lines := make([]logLine, 0, len(c.logs))
+	for i := range c.logs {
+		lines = append(lines, c.logs[(c.head+i)%len(c.logs)])
+	}
+	return lines
+}
+
+// Clear clears all logs, including the repeat counts
 func (c *Console) Clear() {
 	c.mu.Lock()
-	c.logs = make([]string, 0)
+	c.logs = make([]logLine, 0)
+	c.head = 0
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+// SetFilter shows only lines containing text; an empty string shows everything
+func (c *Console) SetFilter(text string) {
+	c.mu.Lock()
+	c.filter = text
 	c.mu.Unlock()
 	c.Refresh()
 }
 
+// Filter returns the active filter text
+func (c *Console) Filter() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.filter
+}
+
+// Lines returns the lines currently shown, respecting the filter
+func (c *Console) Lines() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.filteredLinesLocked()
+}
+
+func (c *Console) filteredLinesLocked() []string {
+	lines := make([]string, 0, len(c.logs))
+	for _, line := range c.orderedLocked() {
+		if c.filter == "" || strings.Contains(line.text, c.filter) {
+			lines = append(lines, line.String())
+		}
+	}
+	return lines
+}
+
+// allLinesLocked returns every line, ignoring the filter
+func (c *Console) allLinesLocked() []string {
+	lines := make([]string, 0, len(c.logs))
+	for _, line := range c.orderedLocked() {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// exportText returns the text to copy or export as plain text lines
+func (c *Console) exportText() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	lines := c.filteredLinesLocked()
+	if c.ExportIgnoresFilter {
+		lines = c.allLinesLocked()
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// CopyToClipboard copies the console lines to the clipboard
+func (c *Console) CopyToClipboard() {
+	fyne.CurrentApp().Clipboard().SetContent(c.exportText())
+}
+
+// ExportToFile writes the console lines to uri as plain text
+func (c *Console) ExportToFile(uri fyne.URI) error {
+	w, err := storage.Writer(uri)
+	if err != nil {
+		return err
+	}
+	if err := c.writeTo(w); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (c *Console) writeTo(w io.Writer) error {
+	_, err := io.WriteString(w, c.exportText())
+	return err
+}
+
+// showExportDialog asks where to save the console lines
+func (c *Console) showExportDialog() {
+	c.mu.RLock()
+	window := c.window
+	c.mu.RUnlock()
+	if window == nil {
+		return
+	}
+
+	save := fynedialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+		if err != nil {
+			fynedialog.ShowError(err, window)
+			return
+		}
+		if writer == nil {
+			return // Cancelled
+		}
+		defer writer.Close()
+		if err := c.writeTo(writer); err != nil {
+			fynedialog.ShowError(err, window)
+		}
+	}, window)
+	save.SetFileName("console.txt")
+	save.Show()
+}
+
 // Show shows the console
 func (c *Console) ShowIn(window fyne.Window) {
 	c.mu.Lock()
@@ -99,7 +288,7 @@ func (c *Console) ShowIn(window fyne.Window) {
 	consoleHeight := float32(200)
 	c.popup.Resize(fyne.NewSize(canvasSize.Width, consoleHeight))
 	c.popup.Move(fyne.NewPos(0, canvasSize.Height-consoleHeight))
-	c.popup.Show()
+	core.SharedOverlayManager().Show(window.Canvas(), c.popup, core.SharedConfiguration().WindowLevelQMUIConsole)
 }
 
 // Hide hides the console
@@ -107,7 +296,7 @@ func (c *Console) Hide() {
 	c.mu.Lock()
 	c.visible = false
 	if c.popup != nil {
-		c.popup.Hide()
+		core.SharedOverlayManager().Hide(c.popup.Canvas, c.popup)
 		c.popup = nil
 	}
 	c.mu.Unlock()
@@ -142,18 +331,22 @@ func (c *Console) buildContent() fyne.CanvasObject {
 		widget.NewButton("Clear", func() {
 			c.Clear()
 		}),
+		widget.NewButton("Copy", func() {
+			c.CopyToClipboard()
+		}),
+		widget.NewButton("Export", func() {
+			c.showExportDialog()
+		}),
 		widget.NewButton("Close", func() {
 			c.Hide()
 		}),
 	)
 
 	// Log text
-	c.mu.RLock()
 	logText := ""
-	for _, line := range c.logs {
+	for _, line := range c.Lines() {
 		logText += line + "\n"
 	}
-	c.mu.RUnlock()
 
 	text := widget.NewLabel(logText)
 	text.Wrapping = fyne.TextWrapWord
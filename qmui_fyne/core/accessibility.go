@@ -0,0 +1,53 @@
+package core
+
+import "strings"
+
+// AccessibilityRole names the kind of control a widget is to assistive
+// technology
+type AccessibilityRole string
+
+const (
+	// AccessibilityRoleButton is a push button
+	AccessibilityRoleButton AccessibilityRole = "button"
+	// AccessibilityRoleCheckbox is a checkbox
+	AccessibilityRoleCheckbox AccessibilityRole = "checkbox"
+	// AccessibilityRoleSwitch is an on/off switch
+	AccessibilityRoleSwitch AccessibilityRole = "switch"
+	// AccessibilityRoleSegmentedControl is a row of mutually exclusive segments
+	AccessibilityRoleSegmentedControl AccessibilityRole = "segmented control"
+)
+
+// AccessibilityDescription is what a screen reader would announce for a
+// widget
+type AccessibilityDescription struct {
+	Role     AccessibilityRole
+	Label    string
+	Value    string
+	Disabled bool
+}
+
+// String returns the announcement, such as "button, OK" or "switch, Wi-Fi,
+// on", with "dimmed" appended for a disabled widget
+func (d AccessibilityDescription) String() string {
+	parts := []string{string(d.Role)}
+	for _, part := range []string{d.Label, d.Value} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if d.Disabled {
+		parts = append(parts, "dimmed")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Accessible is implemented by widgets that describe themselves to assistive
+// technology. The description reflects the widget's current state, so it
+// changes as a switch or checkbox is toggled.
+//
+// Nothing in this module reads it yet: Fyne 2.7 has no accessibility API to
+// hand the description to, so screen readers do not see it. It is here so
+// that apps, tests and a future Fyne bridge can query the same description.
+type Accessible interface {
+	AccessibilityDescription() AccessibilityDescription
+}
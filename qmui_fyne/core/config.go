@@ -6,6 +6,7 @@ package core
 import (
 	"image/color"
 	"sync"
+	"sync/atomic"
 
 	"fyne.io/fyne/v2"
 )
@@ -13,8 +14,9 @@ import (
 // Configuration is the global singleton for UI configuration
 // Mirrors QMUIConfiguration from iOS
 type Configuration struct {
-	active bool
-	mu     sync.RWMutex
+	active    bool
+	mu        sync.RWMutex
+	listeners []func(config *Configuration)
 
 	// Global Colors
 	ClearColor       color.Color
@@ -46,6 +48,7 @@ type Configuration struct {
 	// UIControl
 	ControlHighlightedAlpha float64
 	ControlDisabledAlpha    float64
+	FocusRingColor          color.Color
 
 	// Button
 	ButtonHighlightedAlpha float64
@@ -160,9 +163,18 @@ type Configuration struct {
 	TableViewInsetGroupedCellBackgroundColor       color.Color
 	TableViewInsetGroupedCellSelectedBackgroundColor color.Color
 
-	// WindowLevel (for overlays)
-	WindowLevelQMUIAlertView float32
-	WindowLevelQMUIConsole   float32
+	// WindowLevel (for overlays), see OverlayManager
+	WindowLevelQMUIAlertView         float32
+	WindowLevelQMUIConsole           float32
+	WindowLevelQMUIModalPresentation float32
+	WindowLevelQMUIToast             float32
+
+	// Keyboard (height of the on-screen keyboard, see KeyboardAvoider)
+	KeyboardHeight float32
+
+	// MinimumTouchTarget is the smallest width and height of the hit area
+	// of small tappable controls, which pad it around their visual
+	MinimumTouchTarget float32
 
 	// QMUILog
 	ShouldPrintDefaultLog        bool
@@ -192,7 +204,11 @@ type Configuration struct {
 	// Alert Controller
 	AlertContentMargin            EdgeInsets
 	AlertContentMaximumWidth      float32
+	AlertContentWidthFraction     float32
+	AlertContentWidthLimit        float32
 	AlertSeparatorColor           color.Color
+	AlertTitleColor               color.Color
+	AlertMessageColor             color.Color
 	AlertContentCornerRadius      float32
 	AlertButtonHeight             float32
 	AlertHeaderBackgroundColor    color.Color
@@ -208,6 +224,8 @@ type Configuration struct {
 	// Sheet
 	SheetContentMargin              EdgeInsets
 	SheetContentMaximumWidth        float32
+	SheetContentWidthFraction       float32
+	SheetContentWidthLimit          float32
 	SheetSeparatorColor             color.Color
 	SheetCancelButtonMarginTop      float32
 	SheetContentCornerRadius        float32
@@ -280,14 +298,22 @@ func NewOffset(x, y float32) Offset {
 var (
 	configInstance *Configuration
 	configOnce     sync.Once
+
+	templatesMu      sync.Mutex
+	templates        []ConfigurationTemplate
+	templatesApplied atomic.Bool
 )
 
-// SharedConfiguration returns the singleton Configuration instance
+// SharedConfiguration returns the singleton Configuration instance.
+// Registered templates that apply automatically run on first use.
 func SharedConfiguration() *Configuration {
 	configOnce.Do(func() {
 		configInstance = &Configuration{}
 		configInstance.applyDefaults()
 	})
+	if !templatesApplied.Load() {
+		applyAutomaticTemplates()
+	}
 	return configInstance
 }
 
@@ -296,6 +322,50 @@ func SharedConfiguration() *Configuration {
 func ResetConfigurationForTesting() {
 	configOnce = sync.Once{}
 	configInstance = nil
+
+	templatesMu.Lock()
+	templates = nil
+	templatesApplied.Store(false)
+	templatesMu.Unlock()
+}
+
+// RegisterConfigurationTemplate registers a template to be applied on startup
+// if it should apply automatically. Templates registered after startup that
+// apply automatically are applied immediately.
+func RegisterConfigurationTemplate(t ConfigurationTemplate) {
+	templatesMu.Lock()
+	templates = append(templates, t)
+	applied := templatesApplied.Load()
+	templatesMu.Unlock()
+
+	if applied && t.ShouldApplyTemplateAutomatically() {
+		ApplyTemplate(t)
+	}
+}
+
+// ApplyTemplate applies a configuration template to the shared configuration
+func ApplyTemplate(t ConfigurationTemplate) {
+	t.ApplyConfigurationTemplate()
+}
+
+// applyAutomaticTemplates runs the registered automatic templates once.
+// Templates usually call SharedConfiguration themselves, so the applied flag
+// is set before running them.
+func applyAutomaticTemplates() {
+	templatesMu.Lock()
+	if templatesApplied.Load() {
+		templatesMu.Unlock()
+		return
+	}
+	templatesApplied.Store(true)
+	pending := append([]ConfigurationTemplate(nil), templates...)
+	templatesMu.Unlock()
+
+	for _, t := range pending {
+		if t.ShouldApplyTemplateAutomatically() {
+			ApplyTemplate(t)
+		}
+	}
 }
 
 // IsActive returns whether the configuration is active
@@ -312,6 +382,28 @@ func (c *Configuration) Activate() {
 	c.active = true
 }
 
+// Set changes the configuration through fn while holding its lock, then
+// notifies the change listeners. Prefer it to assigning fields directly
+// once widgets are showing.
+func (c *Configuration) Set(fn func(config *Configuration)) {
+	c.mu.Lock()
+	fn(c)
+	listeners := append([]func(*Configuration){}, c.listeners...)
+	c.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(c)
+	}
+}
+
+// AddChangeListener registers a function called after each change made
+// through Set
+func (c *Configuration) AddChangeListener(listener func(config *Configuration)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, listener)
+}
+
 // applyDefaults sets all default values
 func (c *Configuration) applyDefaults() {
 	c.mu.Lock()
@@ -347,6 +439,7 @@ func (c *Configuration) applyDefaults() {
 	// UIControl
 	c.ControlHighlightedAlpha = 0.5
 	c.ControlDisabledAlpha = 0.5
+	c.FocusRingColor = c.BlueColor
 
 	// Button
 	c.ButtonHighlightedAlpha = 0.5
@@ -456,6 +549,14 @@ func (c *Configuration) applyDefaults() {
 	// WindowLevel
 	c.WindowLevelQMUIAlertView = 1999
 	c.WindowLevelQMUIConsole = 1
+	c.WindowLevelQMUIModalPresentation = 1000
+	c.WindowLevelQMUIToast = 2000
+
+	// Keyboard
+	c.KeyboardHeight = 260
+
+	// Touch target
+	c.MinimumTouchTarget = 44
 
 	// QMUILog
 	c.ShouldPrintDefaultLog = true
@@ -485,7 +586,11 @@ func (c *Configuration) applyDefaults() {
 	// Alert Controller
 	c.AlertContentMargin = NewEdgeInsets(0, 0, 0, 0)
 	c.AlertContentMaximumWidth = 270
+	c.AlertContentWidthFraction = 0.35
+	c.AlertContentWidthLimit = 480
 	c.AlertSeparatorColor = color.RGBA{R: 211, G: 211, B: 219, A: 255}
+	c.AlertTitleColor = c.BlackColor
+	c.AlertMessageColor = c.BlackColor
 	c.AlertContentCornerRadius = 13
 	c.AlertButtonHeight = 44
 	c.AlertHeaderBackgroundColor = color.RGBA{R: 247, G: 247, B: 247, A: 255}
@@ -501,6 +606,8 @@ func (c *Configuration) applyDefaults() {
 	// Sheet
 	c.SheetContentMargin = NewEdgeInsets(10, 10, 10, 10)
 	c.SheetContentMaximumWidth = 414 - 20
+	c.SheetContentWidthFraction = 0.5
+	c.SheetContentWidthLimit = 640
 	c.SheetSeparatorColor = color.RGBA{R: 211, G: 211, B: 219, A: 255}
 	c.SheetCancelButtonMarginTop = 8
 	c.SheetContentCornerRadius = 13
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"reflect"
+	"regexp"
+)
+
+var (
+	colorType  = reflect.TypeOf((*color.Color)(nil)).Elem()
+	hexColorRe = regexp.MustCompile(`^#?([0-9A-Fa-f]{6}|[0-9A-Fa-f]{8})$`)
+)
+
+// SaveJSON writes the configurable fields as JSON.
+// Colors are written as "#RRGGBBAA" hex strings; insets, offsets and sizes as numbers.
+func (c *Configuration) SaveJSON(w io.Writer) error {
+	c.mu.RLock()
+	values := make(map[string]interface{})
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := v.Field(i)
+		if field.Type == colorType {
+			if value.IsNil() {
+				continue
+			}
+			values[field.Name] = ColorToHex(value.Interface().(color.Color))
+			continue
+		}
+		values[field.Name] = value.Interface()
+	}
+	c.mu.RUnlock()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(values)
+}
+
+// LoadJSON reads configurable fields written by SaveJSON.
+// Unknown fields are ignored and missing fields keep their current values.
+// Every field is decoded before any is applied, so on error the
+// configuration is left unchanged. The values are applied through Set, so
+// the change listeners are notified.
+func (c *Configuration) LoadJSON(r io.Reader) error {
+	var values map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&values); err != nil {
+		return err
+	}
+
+	// Decode into new values first, by field index
+	decoded := make(map[int]reflect.Value)
+	t := reflect.TypeOf(c).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		raw, ok := values[field.Name]
+		if !ok || !field.IsExported() {
+			continue
+		}
+		if field.Type == colorType {
+			var hex string
+			if err := json.Unmarshal(raw, &hex); err != nil {
+				return fmt.Errorf("%s: %w", field.Name, err)
+			}
+			if !hexColorRe.MatchString(hex) {
+				return fmt.Errorf("%s: invalid hex color %q", field.Name, hex)
+			}
+			decoded[i] = reflect.ValueOf(ColorFromHex(hex))
+			continue
+		}
+		// Start from the current value, so partial objects merge into it
+		value := reflect.New(field.Type)
+		c.mu.RLock()
+		value.Elem().Set(reflect.ValueOf(c).Elem().Field(i))
+		c.mu.RUnlock()
+		if err := json.Unmarshal(raw, value.Interface()); err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+		decoded[i] = value.Elem()
+	}
+
+	c.Set(func(config *Configuration) {
+		v := reflect.ValueOf(config).Elem()
+		for i, value := range decoded {
+			v.Field(i).Set(value)
+		}
+	})
+	return nil
+}
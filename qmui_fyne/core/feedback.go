@@ -0,0 +1,45 @@
+package core
+
+import "sync"
+
+// FeedbackKind identifies the interaction a widget reports for haptic or
+// sound feedback
+type FeedbackKind int
+
+const (
+	// FeedbackTap is a tap on a button
+	FeedbackTap FeedbackKind = iota
+	// FeedbackToggleOn is a switch or checkbox turned on
+	FeedbackToggleOn
+	// FeedbackToggleOff is a switch or checkbox turned off
+	FeedbackToggleOff
+	// FeedbackSelectionChanged is a new selection, such as another segment
+	FeedbackSelectionChanged
+	// FeedbackDestructiveConfirm is a confirmed destructive action
+	FeedbackDestructiveConfirm
+)
+
+var (
+	feedbackMu      sync.RWMutex
+	feedbackHandler func(kind FeedbackKind)
+)
+
+// SetFeedbackHandler sets the function the QMUI widgets call on meaningful
+// user interactions, so an app can play haptics or sounds for them. Pass nil
+// to turn feedback off.
+func SetFeedbackHandler(handler func(kind FeedbackKind)) {
+	feedbackMu.Lock()
+	feedbackHandler = handler
+	feedbackMu.Unlock()
+}
+
+// Feedback reports an interaction to the feedback handler, if one is set
+func Feedback(kind FeedbackKind) {
+	feedbackMu.RLock()
+	handler := feedbackHandler
+	feedbackMu.RUnlock()
+
+	if handler != nil {
+		handler(kind)
+	}
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// focusRingOutset is how far a focus ring is drawn outside its control
+const focusRingOutset float32 = 3
+
+// NewFocusRing creates the ring drawn around a control with keyboard focus,
+// in Configuration.FocusRingColor. It stays hidden until UpdateFocusRing
+// shows it.
+func NewFocusRing() *canvas.Rectangle {
+	ring := canvas.NewRectangle(color.Transparent)
+	ring.StrokeWidth = 2
+	ring.Hide()
+	return ring
+}
+
+// UpdateFocusRing lays the ring out around a control of size, whose corners
+// have cornerRadius, and shows it while the control is focused
+func UpdateFocusRing(ring *canvas.Rectangle, size fyne.Size, cornerRadius float32, focused bool) {
+	ring.StrokeColor = SharedConfiguration().FocusRingColor
+	ring.CornerRadius = cornerRadius + focusRingOutset
+	ring.Resize(size.AddWidthHeight(focusRingOutset*2, focusRingOutset*2))
+	ring.Move(fyne.NewPos(-focusRingOutset, -focusRingOutset))
+	ring.Hidden = !focused
+	ring.Refresh()
+}
+
+// IsActivationKey returns whether key activates a focused control, as Space
+// and Return do for a button
+func IsActivationKey(key *fyne.KeyEvent) bool {
+	switch key.Name {
+	case fyne.KeySpace, fyne.KeyReturn, fyne.KeyEnter:
+		return true
+	}
+	return false
+}
@@ -3,9 +3,11 @@
 package core
 
 import (
+	"fmt"
 	"image/color"
 	"math"
 	"runtime"
+	"strings"
 
 	"fyne.io/fyne/v2"
 )
@@ -63,6 +65,37 @@ func ColorToRGBA(c color.Color) (r, g, b, a uint8) {
 	return uint8(rr >> 8), uint8(gg >> 8), uint8(bb >> 8), uint8(aa >> 8)
 }
 
+// ColorDefault ties a color field to the configuration color it defaults to
+type ColorDefault struct {
+	Field *color.Color
+	Value color.Color
+}
+
+// ColorDefaults keeps color fields on their configuration defaults as the
+// configuration changes, such as on a theme switch, without overwriting
+// colors that were customized
+type ColorDefaults struct {
+	applied []color.Color
+}
+
+// Apply sets each field that is nil, or still holds the default it was last
+// given, to its current default. Pass the same fields in the same order on
+// every call.
+func (d *ColorDefaults) Apply(defaults ...ColorDefault) {
+	applied := make([]color.Color, len(defaults))
+	for i, def := range defaults {
+		previous := def.Value
+		if i < len(d.applied) {
+			previous = d.applied[i]
+		}
+		if *def.Field == nil || *def.Field == previous {
+			*def.Field = def.Value
+		}
+		applied[i] = def.Value
+	}
+	d.applied = applied
+}
+
 // ColorFromHex creates a color from a hex string (e.g., "#FF5500" or "FF5500")
 func ColorFromHex(hex string) color.Color {
 	if len(hex) == 0 {
@@ -87,6 +120,12 @@ func ColorFromHex(hex string) color.Color {
 	return color.NRGBA{R: r, G: g, B: b, A: a}
 }
 
+// ColorToHex converts a color to a "#RRGGBBAA" hex string
+func ColorToHex(c color.Color) string {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02X%02X%02X%02X", n.R, n.G, n.B, n.A)
+}
+
 func hexParseByte(s string, b *uint8) (bool, error) {
 	var n uint8
 	for _, c := range s {
@@ -194,6 +233,13 @@ func SizeMin(s1, s2 fyne.Size) fyne.Size {
 	)
 }
 
+// TouchTargetSize grows size to at least Configuration.MinimumTouchTarget
+// in each dimension, for the hit area of a small tappable control
+func TouchTargetSize(size fyne.Size) fyne.Size {
+	target := SharedConfiguration().MinimumTouchTarget
+	return SizeMax(size, fyne.NewSquareSize(target))
+}
+
 // Position helpers
 
 // PositionOffset offsets a position by the given amounts
@@ -227,6 +273,29 @@ func TruncateString(s string, maxLen int, suffix string) string {
 	return s[:maxLen-len(suffix)] + suffix
 }
 
+// WrapText breaks text into lines no wider than width when drawn at
+// textSize, breaking between words. A word wider than width gets a line of
+// its own. A width of 0 or less keeps the text on one line.
+func WrapText(text string, textSize float32, style fyne.TextStyle, width float32) []string {
+	words := strings.Fields(text)
+	if width <= 0 || len(words) == 0 {
+		return []string{text}
+	}
+
+	var lines []string
+	line := words[0]
+	for _, word := range words[1:] {
+		candidate := line + " " + word
+		if fyne.MeasureText(candidate, textSize, style).Width <= width {
+			line = candidate
+			continue
+		}
+		lines = append(lines, line)
+		line = word
+	}
+	return append(lines, line)
+}
+
 // StringLength returns the length of a string, optionally counting non-ASCII as 2
 func StringLength(s string, countNonASCIIAsTwo bool) int {
 	if !countNonASCIIAsTwo {
@@ -0,0 +1,181 @@
+package core
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var (
+	// avoidersMu guards avoiders, the started keyboard avoiders told about
+	// focus changes
+	avoidersMu sync.RWMutex
+	avoiders   = make(map[*KeyboardAvoider]bool)
+)
+
+// NotifyFocusChanged tells the started keyboard avoiders that obj gained or
+// lost focus. The QMUI text widgets call it from FocusGained and FocusLost,
+// since Fyne only delivers focus events to the focused widget itself.
+func NotifyFocusChanged(obj fyne.CanvasObject, focused bool) {
+	avoidersMu.RLock()
+	started := make([]*KeyboardAvoider, 0, len(avoiders))
+	for k := range avoiders {
+		started = append(started, k)
+	}
+	avoidersMu.RUnlock()
+
+	for _, k := range started {
+		k.focusChanged(obj, focused)
+	}
+}
+
+// KeyboardAvoider moves content up while an entry inside it has focus, so
+// the on-screen keyboard does not cover the entry. The content moves back
+// once the entry loses focus. Once started it follows the focus of the QMUI
+// text widgets, which report it through NotifyFocusChanged; call Update for
+// other entries.
+type KeyboardAvoider struct {
+	// KeyboardHeight is the height of the keyboard to keep the focused entry
+	// above. It defaults to Configuration.KeyboardHeight on mobile devices
+	// and 0 elsewhere.
+	KeyboardHeight float32
+
+	mu      sync.Mutex
+	canvas  fyne.Canvas
+	content fyne.CanvasObject
+	offset  float32
+	restPos fyne.Position
+	started bool
+}
+
+// NewKeyboardAvoider creates a keyboard avoider that moves content, which
+// is shown on c
+func NewKeyboardAvoider(c fyne.Canvas, content fyne.CanvasObject) *KeyboardAvoider {
+	k := &KeyboardAvoider{canvas: c, content: content}
+	if app := fyne.CurrentApp(); app != nil && app.Driver().Device().IsMobile() {
+		k.KeyboardHeight = SharedConfiguration().KeyboardHeight
+	}
+	return k
+}
+
+// Start follows focus changes until Stop is called, or until the window
+// showing the canvas is closed. It does nothing without a KeyboardHeight, as
+// on desktop, where there is no on-screen keyboard to avoid.
+func (k *KeyboardAvoider) Start() {
+	k.mu.Lock()
+	if k.started || k.KeyboardHeight <= 0 {
+		k.mu.Unlock()
+		return
+	}
+	k.started = true
+	k.mu.Unlock()
+
+	avoidersMu.Lock()
+	avoiders[k] = true
+	avoidersMu.Unlock()
+}
+
+// Stop stops following focus changes and moves the content back
+func (k *KeyboardAvoider) Stop() {
+	k.mu.Lock()
+	k.started = false
+	k.mu.Unlock()
+
+	avoidersMu.Lock()
+	delete(avoiders, k)
+	avoidersMu.Unlock()
+
+	k.setOffset(0)
+}
+
+// focusChanged moves the content for obj gaining focus, or back for obj
+// losing it, stopping once the canvas's window has closed
+func (k *KeyboardAvoider) focusChanged(obj fyne.CanvasObject, focused bool) {
+	if !k.canvasShown() {
+		k.Stop()
+		return
+	}
+	if focused {
+		k.setOffset(k.offsetFor(obj))
+	} else if k.contains(obj) {
+		k.setOffset(0)
+	}
+}
+
+// canvasShown returns whether a window of the app still shows the canvas
+func (k *KeyboardAvoider) canvasShown() bool {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return false
+	}
+	for _, window := range app.Driver().AllWindows() {
+		if window.Canvas() == k.canvas {
+			return true
+		}
+	}
+	return false
+}
+
+// Update moves the content for the entry that currently has focus
+func (k *KeyboardAvoider) Update() {
+	focused, _ := k.canvas.Focused().(fyne.CanvasObject)
+	k.setOffset(k.offsetFor(focused))
+}
+
+// Offset returns how far the content is currently moved up
+func (k *KeyboardAvoider) Offset() float32 {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.offset
+}
+
+// contains returns whether obj is shown within the content
+func (k *KeyboardAvoider) contains(obj fyne.CanvasObject) bool {
+	if obj == nil || !obj.Visible() {
+		return false
+	}
+	driver := fyne.CurrentApp().Driver()
+	if driver.CanvasForObject(obj) != k.canvas {
+		return false
+	}
+	contentPos := driver.AbsolutePositionForObject(k.content)
+	contentSize := k.content.Size()
+	pos := driver.AbsolutePositionForObject(obj)
+	return pos.X >= contentPos.X && pos.Y >= contentPos.Y &&
+		pos.X <= contentPos.X+contentSize.Width && pos.Y <= contentPos.Y+contentSize.Height
+}
+
+// offsetFor returns how far the content must move up so the focused entry
+// sits above the keyboard, or 0 if focused is not inside it
+func (k *KeyboardAvoider) offsetFor(focused fyne.CanvasObject) float32 {
+	if !k.contains(focused) {
+		return 0
+	}
+	driver := fyne.CurrentApp().Driver()
+	entryPos := driver.AbsolutePositionForObject(focused)
+
+	// Measure from where the entry rests, not where it is moved to
+	entryBottom := entryPos.Y + focused.Size().Height + k.Offset()
+	covered := entryBottom + k.KeyboardHeight + theme.Padding() - k.canvas.Size().Height
+	if k.KeyboardHeight <= 0 || covered <= 0 {
+		return 0
+	}
+	return covered
+}
+
+func (k *KeyboardAvoider) setOffset(offset float32) {
+	k.mu.Lock()
+	if offset == k.offset {
+		k.mu.Unlock()
+		return
+	}
+	if k.offset == 0 {
+		k.restPos = k.content.Position()
+	}
+	k.offset = offset
+	pos := k.restPos.SubtractXY(0, offset)
+	k.mu.Unlock()
+
+	k.content.Move(pos)
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// LongPressSlop is how far a press may move before the long press it would
+// become is cancelled
+const LongPressSlop float32 = 8
+
+// LongPress tracks a press that becomes a long press once held without
+// moving further than LongPressSlop. Reorderable widgets such as grid.Grid
+// and navigation.TabBar use it to pick an item up. The zero value is ready
+// to use.
+type LongPress struct {
+	mu    sync.Mutex
+	timer *time.Timer
+	moved float32
+}
+
+// Start begins a press, replacing one in progress. onLongPress is called on
+// the main goroutine once the press has been held for duration, unless it
+// is cancelled first.
+func (l *LongPress) Start(duration time.Duration, onLongPress func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stopLocked()
+	l.moved = 0
+	var timer *time.Timer
+	timer = time.AfterFunc(duration, func() {
+		fyne.Do(func() {
+			l.mu.Lock()
+			current := l.timer == timer
+			if current {
+				l.timer = nil
+			}
+			l.mu.Unlock()
+
+			if current {
+				onLongPress()
+			}
+		})
+	})
+	l.timer = timer
+}
+
+// Moved adds a drag to the press, cancelling it once the press has moved
+// further than LongPressSlop
+func (l *LongPress) Moved(delta fyne.Delta) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.timer == nil {
+		return
+	}
+	l.moved += abs(delta.DX) + abs(delta.DY)
+	if l.moved > LongPressSlop {
+		l.stopLocked()
+	}
+}
+
+// Cancel stops the press before it becomes a long press
+func (l *LongPress) Cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stopLocked()
+}
+
+func (l *LongPress) stopLocked() {
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+}
+
+func abs(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,30 @@
+package core
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+var reducedMotion atomic.Bool
+
+// SetReducedMotion turns reduced motion on or off for all QMUI widgets.
+// While it is on, transitions such as modal slides and action sheet
+// presentation finish instantly. Continuous content like a scrolling marquee
+// or a loading spinner keeps moving.
+func SetReducedMotion(reduced bool) {
+	reducedMotion.Store(reduced)
+}
+
+// ReducedMotion returns whether transitions should be skipped
+func ReducedMotion() bool {
+	return reducedMotion.Load()
+}
+
+// MotionDuration returns d, or 0 while reduced motion is on. Use it for
+// durations that wait on a transition.
+func MotionDuration(d time.Duration) time.Duration {
+	if ReducedMotion() {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,250 @@
+package core
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/mobile"
+)
+
+// OverlayManager keeps a canvas's overlays ordered by window level, so an
+// overlay with a higher level always renders above one with a lower level.
+// Overlays that were not registered are treated as level 0.
+//
+// Pressing Escape (or Back on mobile) dismisses the topmost overlay through
+// its dismiss handler. Passive overlays, such as toasts, are skipped.
+//
+// Escape is caught by wrapping the canvas's OnTypedKey handler when an
+// overlay is shown, so it has two limits:
+//   - A handler the app sets with SetOnTypedKey while an overlay is showing
+//     replaces the wrapper until the next overlay is shown. Set app key
+//     handlers before showing overlays, or call DismissTop from them.
+//   - The canvas handler does not receive keys while a widget has focus.
+//     The QMUI text widgets pass Escape to DismissTopFor; other focusable
+//     widgets in an overlay swallow it.
+type OverlayManager struct {
+	mu         sync.RWMutex
+	levels     map[fyne.CanvasObject]float32
+	dismissers map[fyne.CanvasObject]func() bool
+	passive    map[fyne.CanvasObject]bool
+	canvases   map[fyne.CanvasObject]fyne.Canvas // Where each overlay was shown
+}
+
+var sharedOverlayManager = &OverlayManager{
+	levels:     make(map[fyne.CanvasObject]float32),
+	dismissers: make(map[fyne.CanvasObject]func() bool),
+	passive:    make(map[fyne.CanvasObject]bool),
+	canvases:   make(map[fyne.CanvasObject]fyne.Canvas),
+}
+
+// SharedOverlayManager returns the overlay manager used by the QMUI popups
+func SharedOverlayManager() *OverlayManager {
+	return sharedOverlayManager
+}
+
+// Register sets the window level of an overlay. Call Restack to apply it to
+// an overlay that is already showing.
+func (m *OverlayManager) Register(overlay fyne.CanvasObject, level float32) {
+	m.mu.Lock()
+	m.levels[overlay] = level
+	m.mu.Unlock()
+}
+
+// Unregister forgets the window level, dismiss handler and passive flag of
+// an overlay
+func (m *OverlayManager) Unregister(overlay fyne.CanvasObject) {
+	m.mu.Lock()
+	delete(m.levels, overlay)
+	delete(m.dismissers, overlay)
+	delete(m.passive, overlay)
+	delete(m.canvases, overlay)
+	m.mu.Unlock()
+}
+
+// prune unregisters the overlays shown on c that are no longer on its
+// overlay stack, such as popups closed by a tap outside them or hidden
+// directly rather than through Hide
+func (m *OverlayManager) prune(c fyne.Canvas) {
+	shown := make(map[fyne.CanvasObject]bool)
+	for _, o := range c.Overlays().List() {
+		shown[o] = true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for overlay, overlayCanvas := range m.canvases {
+		if overlayCanvas != c || shown[overlay] {
+			continue
+		}
+		delete(m.levels, overlay)
+		delete(m.dismissers, overlay)
+		delete(m.passive, overlay)
+		delete(m.canvases, overlay)
+	}
+}
+
+// SetDismissHandler sets the function called when Escape is pressed while
+// overlay is the topmost overlay. It returns whether the overlay was
+// dismissed. Overlays without a handler are not dismissible and block
+// Escape for the overlays below them.
+func (m *OverlayManager) SetDismissHandler(overlay fyne.CanvasObject, dismiss func() bool) {
+	m.mu.Lock()
+	if dismiss == nil {
+		delete(m.dismissers, overlay)
+	} else {
+		m.dismissers[overlay] = dismiss
+	}
+	m.mu.Unlock()
+}
+
+// SetPassive marks an overlay that does not take input, so Escape passes
+// through it to the overlay below
+func (m *OverlayManager) SetPassive(overlay fyne.CanvasObject, passive bool) {
+	m.mu.Lock()
+	if passive {
+		m.passive[overlay] = true
+	} else {
+		delete(m.passive, overlay)
+	}
+	m.mu.Unlock()
+}
+
+// DismissTop dismisses the topmost non-passive overlay of the canvas if it
+// is dismissible, returning whether it did
+func (m *OverlayManager) DismissTop(c fyne.Canvas) bool {
+	m.prune(c)
+	overlays := c.Overlays().List()
+	for i := len(overlays) - 1; i >= 0; i-- {
+		m.mu.RLock()
+		passive := m.passive[overlays[i]]
+		dismiss := m.dismissers[overlays[i]]
+		m.mu.RUnlock()
+
+		if passive {
+			continue
+		}
+		return dismiss != nil && dismiss()
+	}
+	return false
+}
+
+// DismissTopFor dismisses the topmost overlay of the canvas showing obj,
+// as DismissTop does. Focused widgets call it for Escape, which the canvas
+// key handler does not receive while they have focus.
+func (m *OverlayManager) DismissTopFor(obj fyne.CanvasObject) bool {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return false
+	}
+	c := app.Driver().CanvasForObject(obj)
+	return c != nil && m.DismissTop(c)
+}
+
+// escapeHandlerCode identifies the handlers made by escapeHandler, which all
+// share their code
+var escapeHandlerCode = reflect.ValueOf(escapeHandler(nil, nil, nil)).Pointer()
+
+// escapeHandler returns a key handler that routes Escape on c to
+// DismissTop, passing other keys, and unhandled Escapes, to previous
+func escapeHandler(m *OverlayManager, c fyne.Canvas, previous func(*fyne.KeyEvent)) func(*fyne.KeyEvent) {
+	return func(ev *fyne.KeyEvent) {
+		if (ev.Name == fyne.KeyEscape || ev.Name == mobile.KeyBack) && m.DismissTop(c) {
+			return
+		}
+		if previous != nil {
+			previous(ev)
+		}
+	}
+}
+
+// installKeyHandler wraps the canvas key handler with escapeHandler, unless
+// it is wrapped already. It is called on every Show, so a handler the app
+// set since the last overlay was shown is wrapped too.
+func (m *OverlayManager) installKeyHandler(c fyne.Canvas) {
+	previous := c.OnTypedKey()
+	if previous != nil && reflect.ValueOf(previous).Pointer() == escapeHandlerCode {
+		return
+	}
+	c.SetOnTypedKey(escapeHandler(m, c, previous))
+}
+
+// Level returns the window level of an overlay, or 0 if it is not registered
+func (m *OverlayManager) Level(overlay fyne.CanvasObject) float32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.levels[overlay]
+}
+
+// Show registers overlay at level, shows it and restacks the canvas overlays.
+// The overlay is expected to add itself to the canvas when shown, as
+// widget.PopUp does.
+func (m *OverlayManager) Show(c fyne.Canvas, overlay fyne.CanvasObject, level float32) {
+	m.Register(overlay, level)
+	m.mu.Lock()
+	m.canvases[overlay] = c
+	m.mu.Unlock()
+	m.installKeyHandler(c)
+	overlay.Show()
+	m.Restack(c)
+}
+
+// Hide hides overlay and unregisters it. Overlays stacked above it stay
+// showing, where hiding it directly would drop them from the canvas.
+func (m *OverlayManager) Hide(c fyne.Canvas, overlay fyne.CanvasObject) {
+	defer m.Unregister(overlay)
+
+	stack := c.Overlays()
+	var above []fyne.CanvasObject
+	for i, o := range stack.List() {
+		if o == overlay {
+			above = append(above, stack.List()[i+1:]...)
+			break
+		}
+	}
+
+	overlay.Hide()
+	stack.Remove(overlay)
+	for _, o := range above {
+		if !m.isOnStack(c, o) {
+			stack.Add(o)
+		}
+	}
+}
+
+// Restack reorders the canvas overlays by window level, keeping the order in
+// which overlays of the same level were shown. Overlays that have left the
+// canvas since they were shown are unregistered.
+func (m *OverlayManager) Restack(c fyne.Canvas) {
+	m.prune(c)
+	stack := c.Overlays()
+	current := append([]fyne.CanvasObject(nil), stack.List()...)
+
+	sorted := append([]fyne.CanvasObject(nil), current...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return m.Level(sorted[i]) < m.Level(sorted[j])
+	})
+
+	for i := range current {
+		if current[i] == sorted[i] {
+			continue
+		}
+		// Removing an overlay also removes everything above it
+		stack.Remove(current[i])
+		for _, o := range sorted[i:] {
+			stack.Add(o)
+		}
+		return
+	}
+}
+
+// isOnStack reports whether overlay is on the canvas overlay stack
+func (m *OverlayManager) isOnStack(c fyne.Canvas, overlay fyne.CanvasObject) bool {
+	for _, o := range c.Overlays().List() {
+		if o == overlay {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package dialog
 
 import (
 	"image/color"
+	"math"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 	"github.com/paul-hammant/qmui_fyne/animation"
 	"github.com/paul-hammant/qmui_fyne/core"
+	"github.com/paul-hammant/qmui_fyne/modal"
 )
 
 // DialogStyle defines the style of dialog
@@ -123,6 +125,11 @@ type Dialog struct {
 	AnimationDuration      time.Duration
 	ShowsCloseButton       bool
 
+	// AnimationStyle is how the dialog is presented and dismissed. Zoom
+	// fades the dimming while scaling the dialog, Fade only fades the
+	// dimming, and the slide styles slide the dialog in and back out.
+	AnimationStyle modal.ModalAnimationStyle
+
 	// Callbacks
 	OnShow    func()
 	OnDismiss func()
@@ -133,6 +140,16 @@ type Dialog struct {
 	window    fyne.Window
 	popup     *widget.PopUp
 	visible   bool
+
+	// Presentation state: the running transition and how far the dialog is
+	// shown, from 0 to 1
+	dimmer     *canvas.Rectangle
+	wrapper    fyne.CanvasObject
+	restPos    fyne.Position
+	restSize   fyne.Size
+	transition *animation.Animation
+	shown      float64
+	dismissing bool
 }
 
 // NewDialog creates a new dialog controller
@@ -156,6 +173,7 @@ func NewDialog() *Dialog {
 		DimmedBackgroundColor: config.MaskDarkColor,
 		DismissOnTapOutside:   true,
 		AnimationDuration:     time.Millisecond * 250,
+		AnimationStyle:        modal.ModalAnimationStyleZoom,
 		ShowsCloseButton:      false,
 		Actions:               make([]*DialogAction, 0),
 	}
@@ -214,6 +232,8 @@ func (dvc *Dialog) Show(window fyne.Window) {
 		return
 	}
 	dvc.visible = true
+	dvc.dismissing = false
+	dvc.shown = 0
 	dvc.window = window
 	dvc.mu.Unlock()
 
@@ -221,6 +241,7 @@ func (dvc *Dialog) Show(window fyne.Window) {
 
 	// Create dimmed background
 	dimmer := canvas.NewRectangle(dvc.DimmedBackgroundColor)
+	dvc.dimmer = dimmer
 
 	// Create popup content with dimmer
 	contentWithDimmer := container.NewStack(dimmer, content)
@@ -230,29 +251,54 @@ func (dvc *Dialog) Show(window fyne.Window) {
 
 	// Animate in
 	dvc.animateShow()
+	core.SharedOverlayManager().SetDismissHandler(dvc.popup, dvc.cancel)
 
 	if dvc.OnShow != nil {
 		dvc.OnShow()
 	}
 }
 
-// Dismiss hides the dialog
+// cancel performs the cancel action, or dismisses the dialog if it can be
+// dismissed by tapping outside, as pressing Escape does
+func (dvc *Dialog) cancel() bool {
+	for _, action := range dvc.Actions {
+		if action.Style == DialogActionStyleCancel && action.IsEnabled {
+			if action.Handler != nil {
+				action.Handler()
+			}
+			dvc.Dismiss()
+			return true
+		}
+	}
+	if dvc.DismissOnTapOutside {
+		dvc.Dismiss()
+		return true
+	}
+	return false
+}
+
+// Dismiss hides the dialog. Dismissing while the dialog is still animating
+// in reverses it from where it is.
 func (dvc *Dialog) Dismiss() {
 	dvc.mu.Lock()
-	if !dvc.visible {
+	if !dvc.visible || dvc.dismissing {
 		dvc.mu.Unlock()
 		return
 	}
+	dvc.dismissing = true
 	dvc.mu.Unlock()
 
 	dvc.animateHide(func() {
 		fyne.Do(func() {
 			dvc.mu.Lock()
 			if dvc.popup != nil {
-				dvc.popup.Hide()
+				core.SharedOverlayManager().Hide(dvc.popup.Canvas, dvc.popup)
 				dvc.popup = nil
 			}
+			dvc.dimmer = nil
+			dvc.wrapper = nil
 			dvc.visible = false
+			dvc.dismissing = false
 			dvc.mu.Unlock()
 
 			if dvc.OnDismiss != nil {
@@ -263,17 +309,82 @@ func (dvc *Dialog) Dismiss() {
 }
 
 func (dvc *Dialog) animateShow() {
-	// Simple fade-in animation
-	if dvc.popup != nil {
-		dvc.popup.Show()
+	if dvc.popup == nil {
+		return
 	}
+	core.SharedOverlayManager().Show(dvc.popup.Canvas, dvc.popup, core.SharedConfiguration().WindowLevelQMUIModalPresentation)
+
+	// The dialog animates from where the popup laid it out
+	if dvc.wrapper != nil {
+		dvc.restPos = dvc.wrapper.Position()
+		dvc.restSize = dvc.wrapper.Size()
+	}
+	dvc.applyTransition(0)
+	dvc.runTransition(1, animation.EaseOutQuad, nil)
 }
 
 func (dvc *Dialog) animateHide(onComplete func()) {
-	// Simple fade-out animation
-	animation.AnimateFloat(1, 0, dvc.AnimationDuration, animation.EaseOutQuad, func(v float64) {
-		// Would animate opacity here if Fyne supported it
-	}, onComplete)
+	dvc.runTransition(0, animation.EaseInQuad, onComplete)
+}
+
+// runTransition animates the dialog from how far it is shown to target,
+// stopping any transition still running, and calls onComplete at the end
+func (dvc *Dialog) runTransition(target float64, easing animation.EasingFunction, onComplete func()) {
+	dvc.mu.Lock()
+	if dvc.transition != nil {
+		dvc.transition.Stop()
+	}
+	from := dvc.shown
+	duration := time.Duration(float64(dvc.AnimationDuration) * math.Abs(target-from))
+	anim := animation.NewAnimation(duration, easing, func(progress float64) {
+		value := from + (target-from)*progress
+		fyne.Do(func() { dvc.applyTransition(value) })
+	})
+	anim.OnComplete = onComplete
+	dvc.transition = anim
+	dvc.mu.Unlock()
+
+	anim.Start()
+}
+
+// applyTransition shows the dialog value of the way for its AnimationStyle
+func (dvc *Dialog) applyTransition(value float64) {
+	dvc.mu.Lock()
+	dvc.shown = value
+	dimmer := dvc.dimmer
+	wrapper := dvc.wrapper
+	window := dvc.window
+	dvc.mu.Unlock()
+
+	if dimmer != nil {
+		_, _, _, a := dvc.DimmedBackgroundColor.RGBA()
+		dimmer.FillColor = core.ColorWithAlpha(dvc.DimmedBackgroundColor, float64(a)/0xffff*value)
+		dimmer.Refresh()
+	}
+	if wrapper == nil || window == nil || dvc.restSize.IsZero() {
+		return
+	}
+
+	pos, size := dvc.restPos, dvc.restSize
+	canvasSize := window.Canvas().Size()
+	hidden := float32(1 - value)
+	switch dvc.AnimationStyle {
+	case modal.ModalAnimationStyleZoom:
+		scale := 0.9 + 0.1*float32(value)
+		scaled := fyne.NewSize(size.Width*scale, size.Height*scale)
+		pos = pos.AddXY((size.Width-scaled.Width)/2, (size.Height-scaled.Height)/2)
+		size = scaled
+	case modal.ModalAnimationStyleSlideUp:
+		pos.Y += (canvasSize.Height - pos.Y) * hidden
+	case modal.ModalAnimationStyleSlideDown:
+		pos.Y -= (pos.Y + size.Height) * hidden
+	case modal.ModalAnimationStyleSlideLeft:
+		pos.X += (canvasSize.Width - pos.X) * hidden
+	case modal.ModalAnimationStyleSlideRight:
+		pos.X -= (pos.X + size.Width) * hidden
+	}
+	wrapper.Resize(size)
+	wrapper.Move(pos)
 }
 
 func (dvc *Dialog) buildDialogContent() fyne.CanvasObject {
@@ -340,6 +451,7 @@ func (dvc *Dialog) buildDialogContent() fyne.CanvasObject {
 		maxWidth: dvc.MaxWidth,
 	}
 	wrapper.ExtendBaseWidget(wrapper)
+	dvc.wrapper = wrapper
 
 	// Center in screen
 	centered := container.NewCenter(wrapper)
@@ -502,6 +614,9 @@ func (b *dialogButton) CreateRenderer() fyne.WidgetRenderer {
 }
 
 func (b *dialogButton) Tapped(*fyne.PointEvent) {
+	if b.action.Style == DialogActionStyleDestructive {
+		core.Feedback(core.FeedbackDestructiveConfirm)
+	}
 	if b.action.Handler != nil {
 		b.action.Handler()
 	}
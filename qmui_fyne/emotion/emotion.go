@@ -9,6 +9,8 @@ import (
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
+
+	"github.com/paul-hammant/qmui_fyne/empty"
 )
 
 // Emotion represents a single emotion/emoji
@@ -47,6 +49,10 @@ type EmojiPicker struct {
 	PageIndicatorColor    color.Color
 	PageIndicatorActiveColor color.Color
 
+	// EmptyText is shown centered in place of the grid while the current
+	// group has no emotions, such as an empty recents group
+	EmptyText string
+
 	// Callbacks
 	OnEmotionSelected func(emotion *Emotion)
 	OnDeletePressed   func()
@@ -72,6 +78,7 @@ func NewEmojiPicker() *EmojiPicker {
 		SelectedBackgroundColor:  color.RGBA{R: 230, G: 230, B: 230, A: 255},
 		PageIndicatorColor:       color.RGBA{R: 200, G: 200, B: 200, A: 255},
 		PageIndicatorActiveColor: color.RGBA{R: 100, G: 100, B: 100, A: 255},
+		EmptyText:                "No recent emoji",
 	}
 	ev.ExtendBaseWidget(ev)
 	return ev
@@ -183,17 +190,20 @@ func (ev *EmojiPicker) CreateRenderer() fyne.WidgetRenderer {
 	ev.ExtendBaseWidget(ev)
 
 	bg := canvas.NewRectangle(ev.BackgroundColor)
+	placeholder := empty.NewEmptyStateWithText(ev.EmptyText)
 
 	return &emotionViewRenderer{
-		view: ev,
-		bg:   bg,
+		view:        ev,
+		bg:          bg,
+		placeholder: placeholder,
 	}
 }
 
 type emotionViewRenderer struct {
-	view     *EmojiPicker
-	bg       *canvas.Rectangle
-	grid     *fyne.Container
+	view        *EmojiPicker
+	bg          *canvas.Rectangle
+	placeholder *empty.EmptyState
+	grid        *fyne.Container
 	buttons  []*emotionButton
 	objects  []fyne.CanvasObject
 }
@@ -211,6 +221,7 @@ func (r *emotionViewRenderer) rebuildGrid(size fyne.Size) {
 	cols := r.view.ColumnsPerPage
 	emotionSize := r.view.EmotionSize
 	spacing := r.view.EmotionSpacing
+	emptyText := r.view.EmptyText
 	r.view.mu.RUnlock()
 
 	// Clear old buttons
@@ -218,6 +229,12 @@ func (r *emotionViewRenderer) rebuildGrid(size fyne.Size) {
 	r.objects = []fyne.CanvasObject{r.bg}
 
 	if len(emotions) == 0 {
+		if r.placeholder.Text != emptyText {
+			r.placeholder.SetText(emptyText)
+		}
+		r.placeholder.Resize(size)
+		r.placeholder.Move(fyne.NewPos(0, 0))
+		r.objects = append(r.objects, r.placeholder)
 		return
 	}
 
@@ -411,35 +428,115 @@ func (eic *EmotionInputController) InsertEmotion(emotion *Emotion) {
 	if eic.TextField == nil {
 		return
 	}
+	InsertEmoji(eic.TextField, emotion.Emoji)
+}
 
-	text := eic.TextField.Text
-	cursorPos := eic.TextField.CursorColumn
+// DeleteLastCharacter deletes the character before the cursor, removing a
+// whole emoji even when it is made of several runes
+func (eic *EmotionInputController) DeleteLastCharacter() {
+	if eic.TextField == nil {
+		return
+	}
+	DeleteBackward(eic.TextField)
+}
 
-	// Insert emoji at cursor
-	if cursorPos >= len(text) {
-		eic.TextField.SetText(text + emotion.Emoji)
-	} else {
-		newText := text[:cursorPos] + emotion.Emoji + text[cursorPos:]
-		eic.TextField.SetText(newText)
+// BindToEntry inserts the emoji selected in picker at the cursor of entry,
+// and makes the picker's delete button remove the emoji before the cursor.
+// Pass &textView.Entry to bind a textview.TextView.
+func BindToEntry(picker *EmojiPicker, entry *widget.Entry) {
+	picker.OnEmotionSelected = func(emotion *Emotion) {
+		InsertEmoji(entry, emotion.Emoji)
+	}
+	picker.OnDeletePressed = func() {
+		DeleteBackward(entry)
 	}
 }
 
-// DeleteLastCharacter deletes the last character
-func (eic *EmotionInputController) DeleteLastCharacter() {
-	if eic.TextField == nil {
+// InsertEmoji inserts emoji at the cursor of entry, replacing any selected
+// text, as pasting it would
+func InsertEmoji(entry *widget.Entry, emoji string) {
+	if emoji == "" || entry.Disabled() {
+		return
+	}
+	entry.TypedShortcut(&fyne.ShortcutPaste{Clipboard: emojiClipboard(emoji)})
+}
+
+// DeleteBackward deletes the selected text of entry, or else the character
+// before its cursor. Emoji made of several runes, such as flags, skin tones
+// and joined sequences, are deleted whole.
+func DeleteBackward(entry *widget.Entry) {
+	if entry.Disabled() {
 		return
 	}
 
-	text := eic.TextField.Text
-	if len(text) > 0 {
-		// Handle UTF-8 properly by converting to runes
-		runes := []rune(text)
-		if len(runes) > 0 {
-			eic.TextField.SetText(string(runes[:len(runes)-1]))
+	count := 1
+	if entry.SelectedText() == "" {
+		runes := []rune(entry.Text)
+		offset := entry.CursorTextOffset()
+		if offset > len(runes) {
+			offset = len(runes)
 		}
+		count = lastCharacterLength(runes[:offset])
+	}
+	for i := 0; i < count; i++ {
+		entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
 	}
 }
 
+const zeroWidthJoiner = '\u200D'
+
+// lastCharacterLength returns how many runes the last character of runes
+// spans, counting an emoji with its modifiers, joined emoji and flags as one
+// character
+func lastCharacterLength(runes []rune) int {
+	if len(runes) == 0 {
+		return 0
+	}
+
+	i := len(runes) - 1
+	for {
+		for i > 0 && isEmojiModifier(runes[i]) {
+			i--
+		}
+		if i >= 2 && runes[i-1] == zeroWidthJoiner {
+			i -= 2
+			continue
+		}
+		break
+	}
+
+	// Flags are pairs of regional indicators
+	if isRegionalIndicator(runes[i]) {
+		run := 0
+		for j := i; j >= 0 && isRegionalIndicator(runes[j]); j-- {
+			run++
+		}
+		if run%2 == 0 {
+			i--
+		}
+	}
+
+	return len(runes) - i
+}
+
+// isEmojiModifier reports whether r modifies the emoji before it: variation
+// selectors, skin tones, the keycap and tag characters
+func isEmojiModifier(r rune) bool {
+	return r == '\uFE0E' || r == '\uFE0F' || r == '\u20E3' ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) || (r >= 0xE0020 && r <= 0xE007F)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// emojiClipboard supplies the emoji being inserted to the paste shortcut
+type emojiClipboard string
+
+func (c emojiClipboard) Content() string { return string(c) }
+
+func (c emojiClipboard) SetContent(string) {}
+
 // Show shows the emotion picker
 func (eic *EmotionInputController) Show(window fyne.Window) {
 	eic.window = window
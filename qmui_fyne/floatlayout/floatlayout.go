@@ -9,6 +9,7 @@ import (
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/paul-hammant/qmui_fyne/animation"
 	"github.com/paul-hammant/qmui_fyne/core"
 )
 
@@ -25,10 +26,19 @@ type FlowLayout struct {
 	// Styling
 	BackgroundColor color.Color
 
+	// Animated animates adding and removing items: added items grow in,
+	// removed items shrink away and the others slide to their new places.
+	// Items only scale and slide with the default transparent background;
+	// they also fade in and out when BackgroundColor is set to an opaque
+	// color, which the fade is drawn with.
+	// Laying the items out again, as on resize, is not animated.
+	Animated bool
+
 	// Items
 	items []fyne.CanvasObject
 
-	mu sync.RWMutex
+	mu         sync.RWMutex
+	transition *animation.LayoutTransition
 }
 
 // NewFlowLayout creates a new float layout view
@@ -40,7 +50,9 @@ func NewFlowLayout() *FlowLayout {
 		MaximumWidth:    0,
 		BackgroundColor: color.Transparent,
 		items:           make([]fyne.CanvasObject, 0),
+		transition:      animation.NewLayoutTransition(),
 	}
+	fv.transition.OnObjectsChanged = fv.Refresh
 	fv.ExtendBaseWidget(fv)
 	return fv
 }
@@ -56,22 +68,25 @@ func NewFlowLayoutWithSpacing(itemSpacing, lineSpacing float32) *FlowLayout {
 // AddItem adds an item to the layout
 func (fv *FlowLayout) AddItem(item fyne.CanvasObject) {
 	fv.mu.Lock()
+	before := append([]fyne.CanvasObject(nil), fv.items...)
 	fv.items = append(fv.items, item)
 	fv.mu.Unlock()
-	fv.Refresh()
+	fv.itemsChanged(before)
 }
 
 // AddItems adds multiple items to the layout
 func (fv *FlowLayout) AddItems(items []fyne.CanvasObject) {
 	fv.mu.Lock()
+	before := append([]fyne.CanvasObject(nil), fv.items...)
 	fv.items = append(fv.items, items...)
 	fv.mu.Unlock()
-	fv.Refresh()
+	fv.itemsChanged(before)
 }
 
 // RemoveItem removes an item from the layout
 func (fv *FlowLayout) RemoveItem(item fyne.CanvasObject) {
 	fv.mu.Lock()
+	before := append([]fyne.CanvasObject(nil), fv.items...)
 	for i, it := range fv.items {
 		if it == item {
 			fv.items = append(fv.items[:i], fv.items[i+1:]...)
@@ -79,25 +94,83 @@ func (fv *FlowLayout) RemoveItem(item fyne.CanvasObject) {
 		}
 	}
 	fv.mu.Unlock()
-	fv.Refresh()
+	fv.itemsChanged(before)
 }
 
 // RemoveAllItems removes all items
 func (fv *FlowLayout) RemoveAllItems() {
 	fv.mu.Lock()
+	before := fv.items
 	fv.items = make([]fyne.CanvasObject, 0)
 	fv.mu.Unlock()
-	fv.Refresh()
+	fv.itemsChanged(before)
 }
 
 // SetItems sets all items
 func (fv *FlowLayout) SetItems(items []fyne.CanvasObject) {
 	fv.mu.Lock()
+	before := fv.items
 	fv.items = items
 	fv.mu.Unlock()
+	fv.itemsChanged(before)
+}
+
+// itemsChanged refreshes the layout after items were added or removed,
+// animating from the items shown before when Animated is set
+func (fv *FlowLayout) itemsChanged(before []fyne.CanvasObject) {
+	fv.mu.RLock()
+	animated := fv.Animated
+	items := fv.items
+	background := fv.BackgroundColor
+	fv.mu.RUnlock()
+
+	size := fv.Size()
+	if animated && !size.IsZero() {
+		fv.transition.CoverColor = background
+		fv.transition.Animate(before, items, fv.itemFrames(items, size))
+	}
 	fv.Refresh()
 }
 
+// itemFrames returns the frame of each item, wrapping lines to fit size
+func (fv *FlowLayout) itemFrames(items []fyne.CanvasObject, size fyne.Size) []animation.LayoutFrame {
+	fv.mu.RLock()
+	itemSpacing := fv.ItemSpacing
+	lineSpacing := fv.LineSpacing
+	insets := fv.ContentInsets
+	fv.mu.RUnlock()
+
+	frames := make([]animation.LayoutFrame, len(items))
+	availableWidth := size.Width - insets.Left - insets.Right
+	x := insets.Left
+	y := insets.Top
+	var lineHeight float32
+
+	for i, item := range items {
+		itemSize := item.MinSize()
+
+		// Check if we need to wrap to next line
+		if x+itemSize.Width > insets.Left+availableWidth && x > insets.Left {
+			x = insets.Left
+			y += lineHeight + lineSpacing
+			lineHeight = 0
+		}
+
+		frames[i] = animation.LayoutFrame{Position: fyne.NewPos(x, y), Size: itemSize}
+
+		x += itemSize.Width + itemSpacing
+		if itemSize.Height > lineHeight {
+			lineHeight = itemSize.Height
+		}
+	}
+	return frames
+}
+
+// IsAnimating returns whether added or removed items are being animated
+func (fv *FlowLayout) IsAnimating() bool {
+	return fv.transition.IsRunning()
+}
+
 // ItemCount returns the number of items
 func (fv *FlowLayout) ItemCount() int {
 	fv.mu.RLock()
@@ -120,44 +193,31 @@ type floatLayoutRenderer struct {
 	background *canvas.Rectangle
 }
 
-func (r *floatLayoutRenderer) Destroy() {}
+func (r *floatLayoutRenderer) Destroy() {
+	r.layout.transition.Stop()
+}
 
 func (r *floatLayoutRenderer) Layout(size fyne.Size) {
 	r.background.Resize(size)
 
 	r.layout.mu.RLock()
 	items := r.layout.items
-	itemSpacing := r.layout.ItemSpacing
-	lineSpacing := r.layout.LineSpacing
-	insets := r.layout.ContentInsets
 	r.layout.mu.RUnlock()
 
 	if len(items) == 0 {
 		return
 	}
 
-	availableWidth := size.Width - insets.Left - insets.Right
-	x := insets.Left
-	y := insets.Top
-	var lineHeight float32
-
-	for _, item := range items {
-		itemSize := item.MinSize()
+	frames := r.layout.itemFrames(items, size)
 
-		// Check if we need to wrap to next line
-		if x+itemSize.Width > insets.Left+availableWidth && x > insets.Left {
-			x = insets.Left
-			y += lineHeight + lineSpacing
-			lineHeight = 0
-		}
-
-		item.Resize(itemSize)
-		item.Move(fyne.NewPos(x, y))
-
-		x += itemSize.Width + itemSpacing
-		if itemSize.Height > lineHeight {
-			lineHeight = itemSize.Height
-		}
+	// Items being added or removed are on their way to their new places
+	if r.layout.transition.IsRunning() {
+		r.layout.transition.Retarget(items, frames)
+		return
+	}
+	for i, item := range items {
+		item.Resize(frames[i].Size)
+		item.Move(frames[i].Position)
 	}
 }
 
@@ -248,7 +308,8 @@ func (r *floatLayoutRenderer) Objects() []fyne.CanvasObject {
 	items := r.layout.items
 	r.layout.mu.RUnlock()
 
-	return append(objects, items...)
+	objects = append(objects, items...)
+	return append(objects, r.layout.transition.Objects()...)
 }
 
 // TagCloud is a common use case for FlowLayout - displaying multiple tags
@@ -264,6 +325,9 @@ type TagCloud struct {
 
 	// Callbacks
 	OnTagTapped func(index int, text string)
+
+	tagMu sync.RWMutex
+	tags  []*Tag
 }
 
 // NewTagCloud creates a new tag cloud
@@ -281,23 +345,66 @@ func NewTagCloud() *TagCloud {
 	return tc
 }
 
-// SetTags sets the tag strings
+// SetTags sets the tag strings. Tags already in the cloud keep their
+// widgets, so only added tags are created and only removed tags dropped.
 func (tc *TagCloud) SetTags(tags []string) {
+	tc.tagMu.Lock()
+	existing := make(map[string][]*Tag, len(tc.tags))
+	for _, tag := range tc.tags {
+		existing[tag.Text] = append(existing[tag.Text], tag)
+	}
+
+	widgets := make([]*Tag, len(tags))
 	items := make([]fyne.CanvasObject, len(tags))
-	for i, tag := range tags {
-		idx := i
-		text := tag
-		tagWidget := NewTag(tag, tc.TagBackgroundColor, tc.TagTextColor, tc.TagFontSize, tc.TagCornerRadius, tc.TagPadding)
-		tagWidget.OnTapped = func() {
-			if tc.OnTagTapped != nil {
-				tc.OnTagTapped(idx, text)
-			}
+	for i, text := range tags {
+		if reuse := existing[text]; len(reuse) > 0 {
+			widgets[i] = reuse[0]
+			existing[text] = reuse[1:]
+		} else {
+			widgets[i] = tc.newTag(text)
 		}
-		items[i] = tagWidget
+		items[i] = widgets[i]
 	}
+	tc.tags = widgets
+	tc.tagMu.Unlock()
+
 	tc.SetItems(items)
 }
 
+// Tags returns the current tag strings
+func (tc *TagCloud) Tags() []string {
+	tc.tagMu.RLock()
+	defer tc.tagMu.RUnlock()
+
+	tags := make([]string, len(tc.tags))
+	for i, tag := range tc.tags {
+		tags[i] = tag.Text
+	}
+	return tags
+}
+
+// newTag creates the widget for a tag, reporting taps with its current index
+func (tc *TagCloud) newTag(text string) *Tag {
+	tag := NewTag(text, tc.TagBackgroundColor, tc.TagTextColor, tc.TagFontSize, tc.TagCornerRadius, tc.TagPadding)
+	tag.OnTapped = func() {
+		if tc.OnTagTapped != nil {
+			tc.OnTagTapped(tc.indexOfTag(tag), text)
+		}
+	}
+	return tag
+}
+
+func (tc *TagCloud) indexOfTag(tag *Tag) int {
+	tc.tagMu.RLock()
+	defer tc.tagMu.RUnlock()
+	for i, t := range tc.tags {
+		if t == tag {
+			return i
+		}
+	}
+	return -1
+}
+
 // Tag is a single tag widget
 type Tag struct {
 	widget.BaseWidget
@@ -4,14 +4,37 @@ package grid
 import (
 	"image/color"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/driver/mobile"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/paul-hammant/qmui_fyne/animation"
 	"github.com/paul-hammant/qmui_fyne/core"
 )
 
+const (
+	// reorderSlideDuration is how long items take to slide to a new slot
+	reorderSlideDuration = time.Millisecond * 200
+)
+
+// GridAlignment defines how a partially filled last row is distributed
+type GridAlignment int
+
+const (
+	// GridAlignmentLeading packs the last row against the leading edge
+	GridAlignmentLeading GridAlignment = iota
+	// GridAlignmentCenter centers the last row
+	GridAlignmentCenter
+	// GridAlignmentTrailing packs the last row against the trailing edge
+	GridAlignmentTrailing
+	// GridAlignmentJustified stretches the last row's items to fill it
+	GridAlignmentJustified
+)
+
 // Grid displays items in a grid layout
 type Grid struct {
 	widget.BaseWidget
@@ -23,16 +46,47 @@ type Grid struct {
 	RowSpacing      float32
 	ContentInsets   core.EdgeInsets
 
+	// ItemAspectRatio forces every cell to this width:height ratio of the
+	// column width, overriding RowHeight. 0 sizes rows by their content.
+	ItemAspectRatio float32
+
+	// HorizontalAlignment distributes the items of a partially filled last
+	// row. Full rows are not affected.
+	HorizontalAlignment GridAlignment
+
 	// Styling
 	BackgroundColor color.Color
 	SeparatorColor  color.Color
 	SeparatorWidth  float32
 	ShowSeparators  bool
 
+	// Reordering: long-press an item to pick it up, then drag it to a new slot
+	Reorderable       bool
+	LongPressDuration time.Duration
+	OnItemMoved       func(from, to int)
+
+	// Animated animates adding and removing items: added items grow in,
+	// removed items shrink away and the others slide to their new slots.
+	// Items only scale and slide with the default transparent background;
+	// they also fade in and out when BackgroundColor is set to an opaque
+	// color, which the fade is drawn with.
+	// Laying the grid out again, as on resize, is not animated.
+	Animated bool
+
 	// Items
 	items []fyne.CanvasObject
 
-	mu sync.RWMutex
+	mu         sync.RWMutex
+	press      core.LongPress
+	dragItem   fyne.CanvasObject
+	dragFrom   int
+	dragTo     int
+	slides     map[fyne.CanvasObject]*animation.PositionAnimation
+	transition *animation.LayoutTransition
+
+	// Keyboard navigation: the item ringed while the grid has focus
+	focused    bool
+	focusIndex int
 }
 
 // NewGrid creates a new grid view
@@ -47,8 +101,13 @@ func NewGrid(columnCount int) *Grid {
 		SeparatorColor:  core.SharedConfiguration().SeparatorColor,
 		SeparatorWidth:  0.5,
 		ShowSeparators:  false,
+		LongPressDuration: time.Millisecond * 500,
 		items:           make([]fyne.CanvasObject, 0),
+		slides:          make(map[fyne.CanvasObject]*animation.PositionAnimation),
+		transition:      animation.NewLayoutTransition(),
+		focusIndex:      -1,
 	}
+	gv.transition.OnObjectsChanged = gv.Refresh
 	gv.ExtendBaseWidget(gv)
 	return gv
 }
@@ -64,14 +123,16 @@ func NewGridWithSpacing(columnCount int, columnSpacing, rowSpacing float32) *Gri
 // AddItem adds an item to the grid
 func (gv *Grid) AddItem(item fyne.CanvasObject) {
 	gv.mu.Lock()
+	before := append([]fyne.CanvasObject(nil), gv.items...)
 	gv.items = append(gv.items, item)
 	gv.mu.Unlock()
-	gv.Refresh()
+	gv.itemsChanged(before)
 }
 
 // RemoveItem removes an item from the grid
 func (gv *Grid) RemoveItem(item fyne.CanvasObject) {
 	gv.mu.Lock()
+	before := append([]fyne.CanvasObject(nil), gv.items...)
 	for i, it := range gv.items {
 		if it == item {
 			gv.items = append(gv.items[:i], gv.items[i+1:]...)
@@ -79,25 +140,60 @@ func (gv *Grid) RemoveItem(item fyne.CanvasObject) {
 		}
 	}
 	gv.mu.Unlock()
-	gv.Refresh()
+	gv.itemsChanged(before)
 }
 
 // ClearItems removes all items
 func (gv *Grid) ClearItems() {
 	gv.mu.Lock()
+	before := gv.items
 	gv.items = make([]fyne.CanvasObject, 0)
 	gv.mu.Unlock()
-	gv.Refresh()
+	gv.itemsChanged(before)
 }
 
 // SetItems sets all items
 func (gv *Grid) SetItems(items []fyne.CanvasObject) {
 	gv.mu.Lock()
+	before := gv.items
 	gv.items = items
 	gv.mu.Unlock()
+	gv.itemsChanged(before)
+}
+
+// itemsChanged refreshes the grid after items were added or removed,
+// animating from the items shown before when Animated is set
+func (gv *Grid) itemsChanged(before []fyne.CanvasObject) {
+	gv.mu.RLock()
+	animated := gv.Animated && gv.dragItem == nil
+	items := gv.items
+	background := gv.BackgroundColor
+	gv.mu.RUnlock()
+
+	size := gv.Size()
+	if animated && !size.IsZero() {
+		gv.transition.CoverColor = background
+		gv.transition.Animate(before, items, gv.slotFrames(items, size))
+	}
 	gv.Refresh()
 }
 
+// slotFrames returns the frame of each item when the grid is laid out at size
+func (gv *Grid) slotFrames(items []fyne.CanvasObject, size fyne.Size) []animation.LayoutFrame {
+	cell := gv.cellSize(size)
+	frames := make([]animation.LayoutFrame, len(items))
+	for i := range items {
+		pos, itemSize := gv.slotFrame(i, cell)
+		frames[i] = animation.LayoutFrame{Position: pos, Size: itemSize}
+	}
+	return frames
+}
+
+// IsAnimating returns whether added or removed items are being animated
+func (gv *Grid) IsAnimating() bool {
+	return gv.transition.IsRunning()
+}
+
 // ItemCount returns the number of items
 func (gv *Grid) ItemCount() int {
 	gv.mu.RLock()
@@ -105,6 +201,396 @@ func (gv *Grid) ItemCount() int {
 	return len(gv.items)
 }
 
+// Items returns the items in their current order
+func (gv *Grid) Items() []fyne.CanvasObject {
+	gv.mu.RLock()
+	defer gv.mu.RUnlock()
+	return append([]fyne.CanvasObject(nil), gv.items...)
+}
+
+// SetReorderable turns drag-to-reorder on or off
+func (gv *Grid) SetReorderable(reorderable bool) {
+	gv.mu.Lock()
+	gv.Reorderable = reorderable
+	gv.mu.Unlock()
+	gv.Refresh()
+}
+
+// cellSize returns the size of each slot when the grid is laid out at size
+func (gv *Grid) cellSize(size fyne.Size) fyne.Size {
+	gv.mu.RLock()
+	items := gv.items
+	columnCount := gv.ColumnCount
+	columnSpacing := gv.ColumnSpacing
+	rowHeight := gv.RowHeight
+	aspectRatio := gv.ItemAspectRatio
+	insets := gv.ContentInsets
+	gv.mu.RUnlock()
+
+	if columnCount <= 0 {
+		return fyne.NewSize(0, 0)
+	}
+
+	availableWidth := size.Width - insets.Left - insets.Right - float32(columnCount-1)*columnSpacing
+	columnWidth := availableWidth / float32(columnCount)
+	if aspectRatio > 0 {
+		return fyne.NewSize(columnWidth, columnWidth/aspectRatio)
+	}
+
+	// Calculate row height if auto
+	if rowHeight <= 0 {
+		for _, item := range items {
+			if h := item.MinSize().Height; h > rowHeight {
+				rowHeight = h
+			}
+		}
+	}
+	return fyne.NewSize(columnWidth, rowHeight)
+}
+
+// slotFrame returns where the item in slot index is placed and its size
+func (gv *Grid) slotFrame(index int, cell fyne.Size) (fyne.Position, fyne.Size) {
+	gv.mu.RLock()
+	defer gv.mu.RUnlock()
+
+	col := index % gv.ColumnCount
+	row := index / gv.ColumnCount
+	offset, width := gv.rowLayoutLocked(row, cell)
+	pos := fyne.NewPos(
+		gv.ContentInsets.Left+offset+float32(col)*(width+gv.ColumnSpacing),
+		gv.ContentInsets.Top+float32(row)*(cell.Height+gv.RowSpacing),
+	)
+	return pos, fyne.NewSize(width, cell.Height)
+}
+
+// rowLayoutLocked returns the offset of the first item in row and the width
+// of its cells, which differ from a full row's only for a partially filled
+// last row. The caller must hold gv.mu.
+func (gv *Grid) rowLayoutLocked(row int, cell fyne.Size) (offset, width float32) {
+	inRow := len(gv.items) - row*gv.ColumnCount
+	if inRow <= 0 || inRow >= gv.ColumnCount {
+		return 0, cell.Width
+	}
+
+	free := float32(gv.ColumnCount-inRow) * (cell.Width + gv.ColumnSpacing)
+	switch gv.HorizontalAlignment {
+	case GridAlignmentCenter:
+		return free / 2, cell.Width
+	case GridAlignmentTrailing:
+		return free, cell.Width
+	case GridAlignmentJustified:
+		return 0, cell.Width + free/float32(inRow)
+	default:
+		return 0, cell.Width
+	}
+}
+
+// slotAt returns the slot whose cell contains pos, clamped to the items
+func (gv *Grid) slotAt(pos fyne.Position, cell fyne.Size) int {
+	gv.mu.RLock()
+	defer gv.mu.RUnlock()
+
+	row := int((pos.Y - gv.ContentInsets.Top) / (cell.Height + gv.RowSpacing))
+	if row < 0 {
+		row = 0
+	}
+	offset, width := gv.rowLayoutLocked(row, cell)
+	col := int((pos.X - gv.ContentInsets.Left - offset) / (width + gv.ColumnSpacing))
+	if col < 0 {
+		col = 0
+	} else if col >= gv.ColumnCount {
+		col = gv.ColumnCount - 1
+	}
+
+	index := row*gv.ColumnCount + col
+	if index >= len(gv.items) {
+		index = len(gv.items) - 1
+	}
+	return index
+}
+
+// orderLocked returns the items in slot order, with a picked up item moved
+// to the slot it is over. The caller must hold gv.mu.
+func (gv *Grid) orderLocked() []fyne.CanvasObject {
+	if gv.dragItem == nil {
+		return gv.items
+	}
+	order := make([]fyne.CanvasObject, 0, len(gv.items))
+	for _, item := range gv.items {
+		if item != gv.dragItem {
+			order = append(order, item)
+		}
+	}
+	order = append(order[:gv.dragTo], append([]fyne.CanvasObject{gv.dragItem}, order[gv.dragTo:]...)...)
+	return order
+}
+
+// pressStarted starts the long press that picks up the item at pos
+func (gv *Grid) pressStarted(pos fyne.Position) {
+	gv.mu.Lock()
+	defer gv.mu.Unlock()
+
+	if !gv.Reorderable || gv.dragItem != nil {
+		return
+	}
+	var item fyne.CanvasObject
+	for _, it := range gv.items {
+		p, s := it.Position(), it.Size()
+		if pos.X >= p.X && pos.X < p.X+s.Width && pos.Y >= p.Y && pos.Y < p.Y+s.Height {
+			item = it
+			break
+		}
+	}
+	if item == nil {
+		return
+	}
+
+	gv.press.Start(gv.LongPressDuration, func() {
+		gv.pickUp(item)
+	})
+}
+
+// pressEnded drops a picked up item, or cancels the long press
+func (gv *Grid) pressEnded() {
+	gv.press.Cancel()
+	gv.mu.RLock()
+	picked := gv.dragItem != nil
+	gv.mu.RUnlock()
+
+	if picked {
+		gv.drop()
+	}
+}
+
+// pickUp lifts item above the others once its long press completes
+func (gv *Grid) pickUp(item fyne.CanvasObject) {
+	gv.mu.Lock()
+	from := -1
+	for i, it := range gv.items {
+		if it == item {
+			from = i
+		}
+	}
+	if from < 0 {
+		gv.mu.Unlock()
+		return
+	}
+	gv.dragItem = item
+	gv.dragFrom = from
+	gv.dragTo = from
+	gv.mu.Unlock()
+
+	gv.Refresh()
+}
+
+// IsReordering returns whether an item is picked up
+func (gv *Grid) IsReordering() bool {
+	gv.mu.RLock()
+	defer gv.mu.RUnlock()
+	return gv.dragItem != nil
+}
+
+// dragged moves the picked up item, making room for it at the slot its
+// center is over
+func (gv *Grid) dragged(ev *fyne.DragEvent) {
+	gv.mu.RLock()
+	item := gv.dragItem
+	gv.mu.RUnlock()
+	if item == nil {
+		// Moving before the long press completes cancels it
+		gv.press.Moved(ev.Dragged)
+		return
+	}
+
+	gv.stopSlide(item)
+	item.Move(item.Position().Add(ev.Dragged))
+
+	cell := gv.cellSize(gv.Size())
+	center := item.Position().AddXY(item.Size().Width/2, item.Size().Height/2)
+	to := gv.slotAt(center, cell)
+
+	gv.mu.Lock()
+	changed := to != gv.dragTo
+	gv.dragTo = to
+	gv.mu.Unlock()
+
+	if changed {
+		gv.slideToSlots()
+	}
+}
+
+// drop places the picked up item in its new slot and reports the move
+// through OnItemMoved
+func (gv *Grid) drop() {
+	gv.press.Cancel()
+	gv.mu.Lock()
+	if gv.dragItem == nil {
+		gv.mu.Unlock()
+		return
+	}
+	gv.items = gv.orderLocked()
+	from, to := gv.dragFrom, gv.dragTo
+	gv.dragItem = nil
+	gv.mu.Unlock()
+
+	gv.slideToSlots()
+	if from != to && gv.OnItemMoved != nil {
+		gv.OnItemMoved(from, to)
+	}
+	gv.Refresh()
+}
+
+// slideToSlots animates every item except a picked up one to its slot
+func (gv *Grid) slideToSlots() {
+	cell := gv.cellSize(gv.Size())
+
+	gv.mu.RLock()
+	order := gv.orderLocked()
+	dragItem := gv.dragItem
+	gv.mu.RUnlock()
+
+	for i, item := range order {
+		if item == dragItem {
+			continue
+		}
+		from := item.Position()
+		to, size := gv.slotFrame(i, cell)
+		item.Resize(size)
+		if from == to {
+			continue
+		}
+
+		gv.stopSlide(item)
+		target := item
+		slide := animation.NewPositionAnimation(
+			float64(from.X), float64(from.Y), float64(to.X), float64(to.Y),
+			reorderSlideDuration,
+			animation.EaseOutCubic,
+			func(x, y float64) {
+				target.Move(fyne.NewPos(float32(x), float32(y)))
+			},
+		)
+		gv.mu.Lock()
+		gv.slides[item] = slide
+		gv.mu.Unlock()
+		slide.Start()
+	}
+}
+
+func (gv *Grid) stopSlide(item fyne.CanvasObject) {
+	gv.mu.Lock()
+	slide := gv.slides[item]
+	delete(gv.slides, item)
+	gv.mu.Unlock()
+
+	if slide != nil {
+		slide.Stop()
+	}
+}
+
+// FocusedIndex returns the index of the item keyboard navigation is on, or
+// -1 if there is none
+func (gv *Grid) FocusedIndex() int {
+	gv.mu.RLock()
+	defer gv.mu.RUnlock()
+	if gv.focusIndex >= len(gv.items) {
+		return len(gv.items) - 1
+	}
+	return gv.focusIndex
+}
+
+// FocusGained implements fyne.Focusable, ringing the first item when no
+// item was focused before
+func (gv *Grid) FocusGained() {
+	gv.mu.Lock()
+	gv.focused = true
+	if gv.focusIndex < 0 && len(gv.items) > 0 {
+		gv.focusIndex = 0
+	}
+	gv.mu.Unlock()
+	gv.Refresh()
+}
+
+// FocusLost implements fyne.Focusable
+func (gv *Grid) FocusLost() {
+	gv.mu.Lock()
+	gv.focused = false
+	gv.mu.Unlock()
+	gv.Refresh()
+}
+
+// TypedRune implements fyne.Focusable
+func (gv *Grid) TypedRune(rune) {}
+
+// TypedKey implements fyne.Focusable. The arrow keys move between items,
+// Home and End jump to the first and last item, and Space or Return taps
+// the focused item.
+func (gv *Grid) TypedKey(key *fyne.KeyEvent) {
+	if core.IsActivationKey(key) {
+		gv.activateFocused()
+		return
+	}
+
+	gv.mu.Lock()
+	count := len(gv.items)
+	if count == 0 || gv.ColumnCount <= 0 {
+		gv.mu.Unlock()
+		return
+	}
+	index := gv.focusIndex
+	if index < 0 || index >= count {
+		index = 0
+	}
+	switch key.Name {
+	case fyne.KeyLeft:
+		index--
+	case fyne.KeyRight:
+		index++
+	case fyne.KeyUp:
+		if index >= gv.ColumnCount {
+			index -= gv.ColumnCount
+		}
+	case fyne.KeyDown:
+		// Moving down into a partially filled last row stops at its last item
+		if index/gv.ColumnCount < (count-1)/gv.ColumnCount {
+			index += gv.ColumnCount
+		}
+	case fyne.KeyHome:
+		index = 0
+	case fyne.KeyEnd:
+		index = count - 1
+	default:
+		gv.mu.Unlock()
+		return
+	}
+	if index < 0 {
+		index = 0
+	} else if index >= count {
+		index = count - 1
+	}
+	changed := index != gv.focusIndex
+	gv.focusIndex = index
+	gv.mu.Unlock()
+
+	if changed {
+		gv.Refresh()
+	}
+}
+
+// activateFocused taps the focused item, if it is tappable
+func (gv *Grid) activateFocused() {
+	gv.mu.RLock()
+	var item fyne.CanvasObject
+	if gv.focusIndex >= 0 && gv.focusIndex < len(gv.items) {
+		item = gv.items[gv.focusIndex]
+	}
+	gv.mu.RUnlock()
+
+	if tappable, ok := item.(fyne.Tappable); ok {
+		tappable.Tapped(&fyne.PointEvent{})
+	}
+}
+
 // CreateRenderer implements fyne.Widget
 func (gv *Grid) CreateRenderer() fyne.WidgetRenderer {
 	gv.ExtendBaseWidget(gv)
@@ -112,6 +598,8 @@ func (gv *Grid) CreateRenderer() fyne.WidgetRenderer {
 	return &gridViewRenderer{
 		grid:       gv,
 		background: background,
+		dragLayer:  newGridDragLayer(gv),
+		focusRing:  core.NewFocusRing(),
 		separators: make([]*canvas.Rectangle, 0),
 	}
 }
@@ -119,58 +607,80 @@ func (gv *Grid) CreateRenderer() fyne.WidgetRenderer {
 type gridViewRenderer struct {
 	grid       *Grid
 	background *canvas.Rectangle
+	dragLayer  *gridDragLayer
+	focusRing  *canvas.Rectangle
 	separators []*canvas.Rectangle
 }
 
-func (r *gridViewRenderer) Destroy() {}
+// updateFocusRing rings the focused item while the grid has focus
+func (r *gridViewRenderer) updateFocusRing() {
+	r.grid.mu.RLock()
+	var item fyne.CanvasObject
+	if r.grid.focused && r.grid.focusIndex >= 0 && r.grid.focusIndex < len(r.grid.items) {
+		item = r.grid.items[r.grid.focusIndex]
+	}
+	r.grid.mu.RUnlock()
+
+	if item == nil {
+		core.UpdateFocusRing(r.focusRing, fyne.NewSize(0, 0), 0, false)
+		return
+	}
+	var cornerRadius float32
+	if gridItem, ok := item.(*GridItem); ok {
+		cornerRadius = gridItem.CornerRadius
+	}
+	core.UpdateFocusRing(r.focusRing, item.Size(), cornerRadius, true)
+	r.focusRing.Move(r.focusRing.Position().Add(item.Position()))
+}
+
+func (r *gridViewRenderer) Destroy() {
+	r.grid.transition.Stop()
+}
 
 func (r *gridViewRenderer) Layout(size fyne.Size) {
 	r.background.Resize(size)
+	r.dragLayer.Resize(size)
 
 	r.grid.mu.RLock()
-	items := r.grid.items
+	items := r.grid.orderLocked()
+	dragItem := r.grid.dragItem
 	columnCount := r.grid.ColumnCount
 	columnSpacing := r.grid.ColumnSpacing
 	rowSpacing := r.grid.RowSpacing
-	rowHeight := r.grid.RowHeight
 	insets := r.grid.ContentInsets
 	r.grid.mu.RUnlock()
 
 	if len(items) == 0 || columnCount <= 0 {
+		r.updateFocusRing()
 		return
 	}
 
-	availableWidth := size.Width - insets.Left - insets.Right - float32(columnCount-1)*columnSpacing
-	columnWidth := availableWidth / float32(columnCount)
-
-	// Calculate row height if auto
-	if rowHeight <= 0 {
-		var maxHeight float32
-		for _, item := range items {
-			h := item.MinSize().Height
-			if h > maxHeight {
-				maxHeight = h
-			}
-		}
-		rowHeight = maxHeight
-	}
-
-	// Layout items
-	for i, item := range items {
-		col := i % columnCount
-		row := i / columnCount
-
-		x := insets.Left + float32(col)*(columnWidth+columnSpacing)
-		y := insets.Top + float32(row)*(rowHeight+rowSpacing)
+	cell := r.grid.cellSize(size)
+	columnWidth, rowHeight := cell.Width, cell.Height
 
-		item.Resize(fyne.NewSize(columnWidth, rowHeight))
-		item.Move(fyne.NewPos(x, y))
+	// Layout items, leaving a picked up item where it was dragged to, and
+	// items being added or removed on their way to their new slots
+	if r.grid.transition.IsRunning() {
+		r.grid.transition.Retarget(items, r.grid.slotFrames(items, size))
+	} else {
+		r.layoutItems(items, dragItem, cell)
 	}
 
 	// Layout separators if needed
 	if r.grid.ShowSeparators {
 		r.layoutSeparators(size, columnWidth, rowHeight, len(items), columnCount, columnSpacing, rowSpacing, insets)
 	}
+	r.updateFocusRing()
+}
+
+func (r *gridViewRenderer) layoutItems(items []fyne.CanvasObject, dragItem fyne.CanvasObject, cell fyne.Size) {
+	for i, item := range items {
+		pos, itemSize := r.grid.slotFrame(i, cell)
+		item.Resize(itemSize)
+		if item != dragItem {
+			item.Move(pos)
+		}
+	}
 }
 
 func (r *gridViewRenderer) layoutSeparators(size fyne.Size, columnWidth, rowHeight float32, itemCount, columnCount int, columnSpacing, rowSpacing float32, insets core.EdgeInsets) {
@@ -202,6 +712,7 @@ func (r *gridViewRenderer) MinSize() fyne.Size {
 	columnSpacing := r.grid.ColumnSpacing
 	rowSpacing := r.grid.RowSpacing
 	rowHeight := r.grid.RowHeight
+	aspectRatio := r.grid.ItemAspectRatio
 	insets := r.grid.ContentInsets
 	r.grid.mu.RUnlock()
 
@@ -224,6 +735,14 @@ func (r *gridViewRenderer) MinSize() fyne.Size {
 	if rowHeight <= 0 {
 		rowHeight = maxHeight
 	}
+	if aspectRatio > 0 {
+		// Rows are as tall as the columns are wide at the current width
+		columnWidth := maxWidth
+		if width := r.grid.cellSize(r.grid.Size()).Width; width > columnWidth {
+			columnWidth = width
+		}
+		rowHeight = columnWidth / aspectRatio
+	}
 
 	rowCount := (len(items) + columnCount - 1) / columnCount
 
@@ -244,6 +763,7 @@ func (r *gridViewRenderer) Refresh() {
 	for _, item := range items {
 		item.Refresh()
 	}
+	r.updateFocusRing()
 }
 
 func (r *gridViewRenderer) Objects() []fyne.CanvasObject {
@@ -251,9 +771,21 @@ func (r *gridViewRenderer) Objects() []fyne.CanvasObject {
 
 	r.grid.mu.RLock()
 	items := r.grid.items
+	reorderable := r.grid.Reorderable
+	dragItem := r.grid.dragItem
 	r.grid.mu.RUnlock()
 
-	objects = append(objects, items...)
+	// The drag layer sits below the items, so it only receives the presses
+	// and drags that the items don't handle themselves
+	if reorderable {
+		objects = append(objects, r.dragLayer)
+	}
+	for _, item := range items {
+		if item != dragItem {
+			objects = append(objects, item)
+		}
+	}
+	objects = append(objects, r.grid.transition.Objects()...)
 
 	if r.grid.ShowSeparators {
 		for _, sep := range r.separators {
@@ -261,9 +793,68 @@ func (r *gridViewRenderer) Objects() []fyne.CanvasObject {
 		}
 	}
 
+	objects = append(objects, r.focusRing)
+
+	// A picked up item floats above everything else
+	if dragItem != nil {
+		objects = append(objects, dragItem)
+	}
+
 	return objects
 }
 
+// gridDragLayer receives the long press and drag that reorder a Grid
+type gridDragLayer struct {
+	widget.BaseWidget
+	grid *Grid
+}
+
+func newGridDragLayer(grid *Grid) *gridDragLayer {
+	l := &gridDragLayer{grid: grid}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// MouseDown implements desktop.Mouseable
+func (l *gridDragLayer) MouseDown(ev *desktop.MouseEvent) {
+	l.grid.pressStarted(ev.Position)
+}
+
+// MouseUp implements desktop.Mouseable
+func (l *gridDragLayer) MouseUp(*desktop.MouseEvent) {
+	l.grid.pressEnded()
+}
+
+// TouchDown implements mobile.Touchable
+func (l *gridDragLayer) TouchDown(ev *mobile.TouchEvent) {
+	l.grid.pressStarted(ev.Position)
+}
+
+// TouchUp implements mobile.Touchable
+func (l *gridDragLayer) TouchUp(*mobile.TouchEvent) {
+	l.grid.pressEnded()
+}
+
+// TouchCancel implements mobile.Touchable
+func (l *gridDragLayer) TouchCancel(*mobile.TouchEvent) {
+	l.grid.pressEnded()
+}
+
+// Dragged implements fyne.Draggable
+func (l *gridDragLayer) Dragged(ev *fyne.DragEvent) {
+	l.grid.dragged(ev)
+}
+
+// DragEnd implements fyne.Draggable
+func (l *gridDragLayer) DragEnd() {
+	l.grid.drop()
+}
+
+// CreateRenderer implements fyne.Widget
+func (l *gridDragLayer) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
 // GridItem is a wrapper for items in the grid
 type GridItem struct {
 	widget.BaseWidget
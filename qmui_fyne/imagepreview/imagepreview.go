@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"image/color"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+
+	"github.com/paul-hammant/qmui_fyne/empty"
 )
 
 // ImagePreview displays images with zoom and pan support
@@ -24,16 +29,31 @@ type ImagePreview struct {
 	PageIndicatorColor color.Color
 
 	// Behavior
-	ZoomEnabled bool
-	MinZoom     float32
-	MaxZoom     float32
+	ZoomEnabled   bool
+	MinZoom       float32
+	MaxZoom       float32
+	DoubleTapZoom float32
+
+	// Toolbar
+	ShowsToolbar         bool
+	ToolbarAutoHideDelay time.Duration
 
 	// Callbacks
 	OnCurrentIndexChanged func(index int)
 	OnDismiss             func()
 	OnLongPress           func(index int)
-
-	mu sync.RWMutex
+	OnZoomChanged         func(scale float32)
+	OnSave                func(res fyne.Resource)
+
+	mu             sync.RWMutex
+	zoom           float32
+	offset         fyne.Position
+	toolbarVisible bool
+	toolbarTimer   *time.Timer
+	loader         func() (fyne.Resource, error)
+	loading        bool
+	loadErr        error
+	loadGen        int
 }
 
 // NewImagePreview creates a new image preview view
@@ -46,6 +66,11 @@ func NewImagePreview() *ImagePreview {
 		ZoomEnabled:        true,
 		MinZoom:            1.0,
 		MaxZoom:            3.0,
+		DoubleTapZoom:        2.0,
+		ShowsToolbar:         true,
+		ToolbarAutoHideDelay: 3 * time.Second,
+		zoom:                 1.0,
+		toolbarVisible:       true,
 	}
 	ipv.ExtendBaseWidget(ipv)
 	return ipv
@@ -61,6 +86,11 @@ func NewImagePreviewWithImages(images []fyne.Resource) *ImagePreview {
 // SetImages sets the images to display
 func (ipv *ImagePreview) SetImages(images []fyne.Resource) {
 	ipv.mu.Lock()
+	// Replaces any image still loading
+	ipv.loader = nil
+	ipv.loading = false
+	ipv.loadErr = nil
+	ipv.loadGen++
 	ipv.Images = images
 	if ipv.CurrentIndex >= len(images) {
 		ipv.CurrentIndex = len(images) - 1
@@ -72,6 +102,75 @@ func (ipv *ImagePreview) SetImages(images []fyne.Resource) {
 	ipv.Refresh()
 }
 
+// SetImageLoader shows the image returned by loader, which is called off the
+// main thread. A spinner shows while it loads and an error state with a
+// retry button if it fails.
+func (ipv *ImagePreview) SetImageLoader(loader func() (fyne.Resource, error)) {
+	ipv.mu.Lock()
+	ipv.loader = loader
+	ipv.Images = nil
+	ipv.CurrentIndex = 0
+	ipv.mu.Unlock()
+	ipv.ReloadImage()
+}
+
+// ReloadImage calls the image loader again, as the retry button does
+func (ipv *ImagePreview) ReloadImage() {
+	ipv.mu.Lock()
+	loader := ipv.loader
+	ipv.loadGen++
+	gen := ipv.loadGen
+	ipv.loading = loader != nil
+	ipv.loadErr = nil
+	ipv.mu.Unlock()
+	ipv.Refresh()
+
+	if loader == nil {
+		return
+	}
+	go func() {
+		res, err := loader()
+		fyne.Do(func() {
+			ipv.finishLoading(gen, res, err)
+		})
+	}()
+}
+
+// finishLoading swaps in the loaded image, or the error, unless the load was
+// superseded
+func (ipv *ImagePreview) finishLoading(gen int, res fyne.Resource, err error) {
+	ipv.mu.Lock()
+	if gen != ipv.loadGen {
+		ipv.mu.Unlock()
+		return
+	}
+	ipv.loading = false
+	if err != nil {
+		ipv.loadErr = err
+	} else {
+		ipv.Images = []fyne.Resource{res}
+		ipv.CurrentIndex = 0
+		ipv.zoom = 1.0
+		ipv.offset = fyne.NewPos(0, 0)
+	}
+	ipv.mu.Unlock()
+	ipv.Refresh()
+}
+
+// IsLoading returns whether the image loader is running
+func (ipv *ImagePreview) IsLoading() bool {
+	ipv.mu.RLock()
+	defer ipv.mu.RUnlock()
+	return ipv.loading
+}
+
+// LoadError returns the error from the last call of the image loader, if any
+func (ipv *ImagePreview) LoadError() error {
+	ipv.mu.RLock()
+	defer ipv.mu.RUnlock()
+	return ipv.loadErr
+}
+
 // SetCurrentIndex sets the current image index
 func (ipv *ImagePreview) SetCurrentIndex(index int) {
 	ipv.mu.Lock()
@@ -82,6 +181,8 @@ func (ipv *ImagePreview) SetCurrentIndex(index int) {
 		index = len(ipv.Images) - 1
 	}
 	ipv.CurrentIndex = index
+	ipv.zoom = 1.0
+	ipv.offset = fyne.NewPos(0, 0)
 	ipv.mu.Unlock()
 	ipv.Refresh()
 
@@ -113,8 +214,208 @@ func (ipv *ImagePreview) Previous() {
 	}
 }
 
-// Dragged implements fyne.Draggable for swipe navigation
+// CurrentImage returns the currently displayed image, or nil
+func (ipv *ImagePreview) CurrentImage() fyne.Resource {
+	ipv.mu.RLock()
+	defer ipv.mu.RUnlock()
+	if ipv.CurrentIndex < 0 || ipv.CurrentIndex >= len(ipv.Images) {
+		return nil
+	}
+	return ipv.Images[ipv.CurrentIndex]
+}
+
+// Save passes the currently displayed image to OnSave
+func (ipv *ImagePreview) Save() {
+	res := ipv.CurrentImage()
+	if res != nil && ipv.OnSave != nil {
+		ipv.OnSave(res)
+	}
+}
+
+// IsToolbarVisible returns whether the overlay toolbar is currently shown
+func (ipv *ImagePreview) IsToolbarVisible() bool {
+	ipv.mu.RLock()
+	defer ipv.mu.RUnlock()
+	return ipv.ShowsToolbar && ipv.OnSave != nil && ipv.toolbarVisible
+}
+
+// showToolbar shows the overlay toolbar and restarts the auto-hide timer
+func (ipv *ImagePreview) showToolbar() {
+	ipv.mu.Lock()
+	changed := !ipv.toolbarVisible
+	ipv.toolbarVisible = true
+	if ipv.toolbarTimer != nil {
+		ipv.toolbarTimer.Stop()
+	}
+	ipv.toolbarTimer = nil
+	if ipv.ToolbarAutoHideDelay > 0 {
+		ipv.toolbarTimer = time.AfterFunc(ipv.ToolbarAutoHideDelay, func() {
+			fyne.Do(ipv.hideToolbar)
+		})
+	}
+	ipv.mu.Unlock()
+
+	if changed {
+		ipv.Refresh()
+	}
+}
+
+// hideToolbar hides the overlay toolbar
+func (ipv *ImagePreview) hideToolbar() {
+	ipv.mu.Lock()
+	changed := ipv.toolbarVisible
+	ipv.toolbarVisible = false
+	ipv.toolbarTimer = nil
+	ipv.mu.Unlock()
+
+	if changed {
+		ipv.Refresh()
+	}
+}
+
+// MouseIn shows the toolbar when the pointer enters
+func (ipv *ImagePreview) MouseIn(*desktop.MouseEvent) {
+	ipv.showToolbar()
+}
+
+// MouseMoved shows the toolbar on pointer movement
+func (ipv *ImagePreview) MouseMoved(*desktop.MouseEvent) {
+	ipv.showToolbar()
+}
+
+// MouseOut implements desktop.Hoverable
+func (ipv *ImagePreview) MouseOut() {}
+
+// Zoom returns the current zoom scale
+func (ipv *ImagePreview) Zoom() float32 {
+	ipv.mu.RLock()
+	defer ipv.mu.RUnlock()
+	return ipv.zoom
+}
+
+// SetZoom sets the zoom scale, centered on the middle of the view
+func (ipv *ImagePreview) SetZoom(scale float32) {
+	size := ipv.Size()
+	ipv.zoomAt(scale, fyne.NewPos(size.Width/2, size.Height/2))
+}
+
+// ResetZoom returns to the fitted image
+func (ipv *ImagePreview) ResetZoom() {
+	ipv.mu.Lock()
+	changed := ipv.zoom != 1.0
+	ipv.zoom = 1.0
+	ipv.offset = fyne.NewPos(0, 0)
+	ipv.mu.Unlock()
+	ipv.Refresh()
+
+	if changed && ipv.OnZoomChanged != nil {
+		ipv.OnZoomChanged(1.0)
+	}
+}
+
+// Pinched applies a pinch gesture scale factor centered on a point
+func (ipv *ImagePreview) Pinched(scaleDelta float32, center fyne.Position) {
+	if !ipv.ZoomEnabled || scaleDelta <= 0 {
+		return
+	}
+	ipv.zoomAt(ipv.Zoom()*scaleDelta, center)
+}
+
+// zoomAt sets the zoom scale keeping the image point under center fixed
+func (ipv *ImagePreview) zoomAt(scale float32, center fyne.Position) {
+	size := ipv.Size()
+
+	ipv.mu.Lock()
+	if scale < ipv.MinZoom {
+		scale = ipv.MinZoom
+	}
+	if scale > ipv.MaxZoom {
+		scale = ipv.MaxZoom
+	}
+	if scale < 1.0 {
+		scale = 1.0
+	}
+	old := ipv.zoom
+	if scale == old {
+		ipv.mu.Unlock()
+		return
+	}
+
+	// Keep the point under center at the same position on screen
+	ratio := scale / old
+	originX := center.X - size.Width/2
+	originY := center.Y - size.Height/2
+	ipv.offset = fyne.NewPos(
+		originX-(originX-ipv.offset.X)*ratio,
+		originY-(originY-ipv.offset.Y)*ratio,
+	)
+	ipv.zoom = scale
+	ipv.constrainOffset(size)
+	ipv.mu.Unlock()
+	ipv.Refresh()
+
+	if ipv.OnZoomChanged != nil {
+		ipv.OnZoomChanged(scale)
+	}
+}
+
+// constrainOffset keeps the zoomed image covering the view.
+// Callers must hold ipv.mu.
+func (ipv *ImagePreview) constrainOffset(size fyne.Size) {
+	maxX := (size.Width*ipv.zoom - size.Width) / 2
+	maxY := (size.Height*ipv.zoom - size.Height) / 2
+	ipv.offset.X = clamp(ipv.offset.X, -maxX, maxX)
+	ipv.offset.Y = clamp(ipv.offset.Y, -maxY, maxY)
+}
+
+func clamp(v, min, max float32) float32 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// Scrolled implements fyne.Scrollable for wheel zoom centered on the cursor
+func (ipv *ImagePreview) Scrolled(e *fyne.ScrollEvent) {
+	if !ipv.ZoomEnabled {
+		return
+	}
+	scale := ipv.Zoom()
+	if e.Scrolled.DY > 0 {
+		scale *= 1.1
+	} else if e.Scrolled.DY < 0 {
+		scale /= 1.1
+	}
+	ipv.zoomAt(scale, e.Position)
+}
+
+// DoubleTapped toggles between the fitted image and a zoom at the tapped point
+func (ipv *ImagePreview) DoubleTapped(e *fyne.PointEvent) {
+	if !ipv.ZoomEnabled {
+		return
+	}
+	if ipv.Zoom() > 1.0 {
+		ipv.ResetZoom()
+		return
+	}
+	ipv.zoomAt(ipv.DoubleTapZoom, e.Position)
+}
+
+// Dragged implements fyne.Draggable for swipe navigation, or panning when zoomed in
 func (ipv *ImagePreview) Dragged(e *fyne.DragEvent) {
+	ipv.mu.Lock()
+	if ipv.zoom > 1.0 {
+		ipv.offset = ipv.offset.Add(e.Dragged)
+		ipv.constrainOffset(ipv.Size())
+		ipv.mu.Unlock()
+		ipv.Refresh()
+		return
+	}
+	ipv.mu.Unlock()
+
 	// Horizontal swipe detection
 	if e.Dragged.DX > 50 {
 		ipv.Previous()
@@ -142,10 +443,26 @@ func (ipv *ImagePreview) CreateRenderer() fyne.WidgetRenderer {
 
 	background := canvas.NewRectangle(ipv.BackgroundColor)
 
-	return &imagePreviewRenderer{
+	toolbarBg := canvas.NewRectangle(color.NRGBA{A: 128})
+	toolbarBg.CornerRadius = 8
+	saveButton := widget.NewButtonWithIcon("", theme.DocumentSaveIcon(), ipv.Save)
+	saveButton.Importance = widget.LowImportance
+
+	spinner := widget.NewActivity()
+	errorState := empty.ErrorEmptyState("", ipv.ReloadImage)
+
+	ipv.showToolbar()
+
+	r := &imagePreviewRenderer{
 		preview:    ipv,
 		background: background,
+		toolbarBg:  toolbarBg,
+		saveButton: saveButton,
+		spinner:    spinner,
+		errorState: errorState,
 	}
+	r.Refresh()
+	return r
 }
 
 type imagePreviewRenderer struct {
@@ -153,9 +470,15 @@ type imagePreviewRenderer struct {
 	background *canvas.Rectangle
 	imageView  *canvas.Image
 	pageLabel  *canvas.Text
+	toolbarBg  *canvas.Rectangle
+	saveButton *widget.Button
+	spinner    *widget.Activity
+	errorState *empty.EmptyState
 }
 
-func (r *imagePreviewRenderer) Destroy() {}
+func (r *imagePreviewRenderer) Destroy() {
+	r.spinner.Stop()
+}
 
 func (r *imagePreviewRenderer) Layout(size fyne.Size) {
 	r.background.Resize(size)
@@ -163,6 +486,8 @@ func (r *imagePreviewRenderer) Layout(size fyne.Size) {
 	r.preview.mu.RLock()
 	images := r.preview.Images
 	currentIndex := r.preview.CurrentIndex
+	zoom := r.preview.zoom
+	offset := r.preview.offset
 	r.preview.mu.RUnlock()
 
 	if len(images) > 0 && currentIndex >= 0 && currentIndex < len(images) {
@@ -172,7 +497,12 @@ func (r *imagePreviewRenderer) Layout(size fyne.Size) {
 		} else {
 			r.imageView.Resource = images[currentIndex]
 		}
-		r.imageView.Resize(size)
+		zoomedSize := fyne.NewSize(size.Width*zoom, size.Height*zoom)
+		r.imageView.Resize(zoomedSize)
+		r.imageView.Move(fyne.NewPos(
+			(size.Width-zoomedSize.Width)/2+offset.X,
+			(size.Height-zoomedSize.Height)/2+offset.Y,
+		))
 	}
 
 	// Page indicator
@@ -183,6 +513,19 @@ func (r *imagePreviewRenderer) Layout(size fyne.Size) {
 	r.pageLabel.Text = fmt.Sprintf("%d / %d", currentIndex+1, len(images))
 	labelSize := r.pageLabel.MinSize()
 	r.pageLabel.Move(fyne.NewPos((size.Width-labelSize.Width)/2, size.Height-labelSize.Height-20))
+
+	// Overlay toolbar in the top-trailing corner
+	padding := float32(12)
+	buttonSize := r.saveButton.MinSize()
+	r.saveButton.Resize(buttonSize)
+	r.saveButton.Move(fyne.NewPos(size.Width-buttonSize.Width-padding-4, padding+4))
+	r.toolbarBg.Resize(fyne.NewSize(buttonSize.Width+8, buttonSize.Height+8))
+	r.toolbarBg.Move(fyne.NewPos(size.Width-buttonSize.Width-padding-8, padding))
+
+	spinnerSize := r.spinner.MinSize()
+	r.spinner.Resize(spinnerSize)
+	r.spinner.Move(fyne.NewPos((size.Width-spinnerSize.Width)/2, (size.Height-spinnerSize.Height)/2))
+	r.errorState.Resize(size)
 }
 
 func (r *imagePreviewRenderer) MinSize() fyne.Size {
@@ -190,16 +533,48 @@ func (r *imagePreviewRenderer) MinSize() fyne.Size {
 }
 
 func (r *imagePreviewRenderer) Refresh() {
+	r.Layout(r.preview.Size())
+
 	r.background.FillColor = r.preview.BackgroundColor
 	r.background.Refresh()
 
+	// The image and page indicator give way to the loading and error states
+	loading := r.preview.IsLoading()
+	loadErr := r.preview.LoadError()
+	showsImage := !loading && loadErr == nil
 	if r.imageView != nil {
+		r.imageView.Hidden = !showsImage
 		r.imageView.Refresh()
 	}
 	if r.pageLabel != nil {
+		r.pageLabel.Hidden = !showsImage
 		r.pageLabel.Color = r.preview.PageIndicatorColor
 		r.pageLabel.Refresh()
 	}
+
+	if loading {
+		r.spinner.Show()
+		r.spinner.Start()
+	} else {
+		r.spinner.Stop()
+		r.spinner.Hide()
+	}
+	if loadErr != nil {
+		r.errorState.SetDetailText(loadErr.Error())
+		r.errorState.Show()
+	} else {
+		r.errorState.Hide()
+	}
+
+	if r.preview.IsToolbarVisible() {
+		r.toolbarBg.Show()
+		r.saveButton.Show()
+	} else {
+		r.toolbarBg.Hide()
+		r.saveButton.Hide()
+	}
+	r.toolbarBg.Refresh()
+	r.saveButton.Refresh()
 }
 
 func (r *imagePreviewRenderer) Objects() []fyne.CanvasObject {
@@ -210,6 +585,7 @@ func (r *imagePreviewRenderer) Objects() []fyne.CanvasObject {
 	if r.pageLabel != nil {
 		objects = append(objects, r.pageLabel)
 	}
+	objects = append(objects, r.spinner, r.errorState, r.toolbarBg, r.saveButton)
 	return objects
 }
 
@@ -4,6 +4,7 @@ package label
 
 import (
 	"image/color"
+	"strings"
 	"sync"
 
 	"fyne.io/fyne/v2"
@@ -42,11 +43,21 @@ type Label struct {
 
 	// State
 	mu          sync.RWMutex
+	runs        []TextRun
 	hovered     bool
 	highlighted bool
 	longPressed bool
 }
 
+// TextRun is a span of attributed text with its own styling. A nil Color or
+// zero Size uses the label's Color or TextSize.
+type TextRun struct {
+	Text  string
+	Color color.Color
+	Size  float32
+	Style fyne.TextStyle
+}
+
 // NewLabel creates a new QMUI-styled label
 func NewLabel(text string) *Label {
 	l := &Label{
@@ -71,14 +82,38 @@ func NewLabelWithStyle(text string, alignment fyne.TextAlign, style fyne.TextSty
 	return l
 }
 
-// SetText updates the label text
+// SetText updates the label text, replacing any attributed text
 func (l *Label) SetText(text string) {
 	l.mu.Lock()
 	l.Text = text
+	l.runs = nil
+	l.mu.Unlock()
+	l.Refresh()
+}
+
+// SetAttributedText shows runs of differently styled text inline, wrapping
+// between words when Wrapping is on. Text is set to the combined text.
+func (l *Label) SetAttributedText(runs []TextRun) {
+	var text strings.Builder
+	for _, run := range runs {
+		text.WriteString(run.Text)
+	}
+
+	l.mu.Lock()
+	l.runs = append([]TextRun(nil), runs...)
+	l.Text = text.String()
 	l.mu.Unlock()
 	l.Refresh()
 }
 
+// AttributedText returns the runs set by SetAttributedText, or nil for
+// plain text
+func (l *Label) AttributedText() []TextRun {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return append([]TextRun(nil), l.runs...)
+}
+
 // SetHighlighted sets the highlighted state
 func (l *Label) SetHighlighted(highlighted bool) {
 	l.mu.Lock()
@@ -97,11 +132,13 @@ func (l *Label) CreateRenderer() fyne.WidgetRenderer {
 	text.TextSize = l.TextSize
 	text.Alignment = l.Alignment
 
-	return &labelRenderer{
+	r := &labelRenderer{
 		label:      l,
 		background: background,
 		text:       text,
 	}
+	r.Refresh()
+	return r
 }
 
 // Tapped handles tap events
@@ -152,6 +189,8 @@ type labelRenderer struct {
 	label      *Label
 	background *canvas.Rectangle
 	text       *canvas.Text
+	// Words of the attributed text runs, laid out inline
+	runTexts []*canvas.Text
 }
 
 func (r *labelRenderer) Destroy() {}
@@ -161,6 +200,16 @@ func (r *labelRenderer) Layout(size fyne.Size) {
 	r.background.Move(fyne.NewPos(0, 0))
 
 	insets := r.label.ContentEdgeInsets
+	if len(r.runTexts) > 0 {
+		width := size.Width - insets.Left - insets.Right
+		positions, _ := r.flowRuns(width)
+		for i, text := range r.runTexts {
+			text.Resize(text.MinSize())
+			text.Move(positions[i].AddXY(insets.Left, insets.Top))
+		}
+		return
+	}
+
 	textPos := fyne.NewPos(insets.Left, insets.Top)
 	textSize := fyne.NewSize(
 		size.Width-insets.Left-insets.Right,
@@ -174,6 +223,9 @@ func (r *labelRenderer) Layout(size fyne.Size) {
 func (r *labelRenderer) MinSize() fyne.Size {
 	textSize := r.text.MinSize()
 	insets := r.label.ContentEdgeInsets
+	if len(r.runTexts) > 0 {
+		_, textSize = r.flowRuns(r.label.Size().Width - insets.Left - insets.Right)
+	}
 	return fyne.NewSize(
 		textSize.Width+insets.Left+insets.Right,
 		textSize.Height+insets.Top+insets.Bottom,
@@ -199,11 +251,88 @@ func (r *labelRenderer) Refresh() {
 	r.text.TextSize = r.label.TextSize
 	r.text.Alignment = r.label.Alignment
 
+	r.label.mu.RLock()
+	runs := r.label.runs
+	r.label.mu.RUnlock()
+	r.runTexts = nil
+	for _, run := range runs {
+		textColor := run.Color
+		if textColor == nil {
+			textColor = r.label.Color
+		}
+		textSize := run.Size
+		if textSize <= 0 {
+			textSize = r.label.TextSize
+		}
+		// One text per word so wrapping can break between them
+		for _, word := range strings.SplitAfter(run.Text, " ") {
+			if word == "" {
+				continue
+			}
+			text := canvas.NewText(word, textColor)
+			text.TextSize = textSize
+			text.TextStyle = run.Style
+			r.runTexts = append(r.runTexts, text)
+		}
+	}
+
 	r.background.Refresh()
 	r.text.Refresh()
+	r.Layout(r.label.Size())
+}
+
+// flowRuns places the run words in lines no wider than width when wrapping
+// is on, aligning the words of each line on their bottom edge. It returns
+// each word's position and the size of the laid out text.
+func (r *labelRenderer) flowRuns(width float32) ([]fyne.Position, fyne.Size) {
+	wrap := r.label.Wrapping != fyne.TextWrapOff && width > 0
+	positions := make([]fyne.Position, len(r.runTexts))
+
+	var total fyne.Size
+	var y float32
+	for start := 0; start < len(r.runTexts); {
+		// Fill the line
+		end := start
+		var lineWidth, lineHeight float32
+		for end < len(r.runTexts) {
+			size := r.runTexts[end].MinSize()
+			if wrap && end > start && lineWidth+size.Width > width {
+				break
+			}
+			lineWidth += size.Width
+			lineHeight = fyne.Max(lineHeight, size.Height)
+			end++
+		}
+
+		x := float32(0)
+		switch r.label.Alignment {
+		case fyne.TextAlignCenter:
+			x = fyne.Max(0, (width-lineWidth)/2)
+		case fyne.TextAlignTrailing:
+			x = fyne.Max(0, width-lineWidth)
+		}
+		for i := start; i < end; i++ {
+			size := r.runTexts[i].MinSize()
+			positions[i] = fyne.NewPos(x, y+lineHeight-size.Height)
+			x += size.Width
+		}
+
+		total.Width = fyne.Max(total.Width, lineWidth)
+		y += lineHeight
+		start = end
+	}
+	total.Height = y
+	return positions, total
 }
 
 func (r *labelRenderer) Objects() []fyne.CanvasObject {
+	if len(r.runTexts) > 0 {
+		objects := []fyne.CanvasObject{r.background}
+		for _, text := range r.runTexts {
+			objects = append(objects, text)
+		}
+		return objects
+	}
 	return []fyne.CanvasObject{r.background, r.text}
 }
 
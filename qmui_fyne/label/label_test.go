@@ -1,9 +1,11 @@
 package label
 
 import (
+	"image/color"
 	"testing"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/test"
 
 	"github.com/paul-hammant/qmui_fyne/core"
@@ -81,3 +83,46 @@ func TestLabel_TextChangesVisually(t *testing.T) {
 
 	w.Close()
 }
+
+func TestLabel_AttributedText(t *testing.T) {
+	lbl := NewLabel("")
+	lbl.SetAttributedText([]TextRun{
+		{Text: "Total: "},
+		{Text: "$42.00", Color: color.RGBA{R: 255, A: 255}, Size: 24, Style: fyne.TextStyle{Bold: true}},
+	})
+	if lbl.Text != "Total: $42.00" {
+		t.Errorf("Text should combine the runs, got %q", lbl.Text)
+	}
+
+	renderer := test.WidgetRenderer(lbl)
+	var amount *canvas.Text
+	for _, o := range renderer.Objects() {
+		if text, ok := o.(*canvas.Text); ok && text.Text == "$42.00" {
+			amount = text
+		}
+	}
+	if amount == nil || !amount.TextStyle.Bold || amount.TextSize != 24 {
+		t.Fatal("The amount run should be drawn bold at its own size")
+	}
+
+	plain := renderer.MinSize()
+	lbl.ContentEdgeInsets = core.NewEdgeInsets(4, 8, 4, 8)
+	lbl.Refresh()
+	if inset := renderer.MinSize(); inset.Width != plain.Width+16 || inset.Height != plain.Height+8 {
+		t.Errorf("Insets should add to the attributed text size: %v then %v", plain, inset)
+	}
+	if plain.Height < amount.MinSize().Height {
+		t.Error("Min height should fit the tallest run")
+	}
+
+	lbl.Wrapping = fyne.TextWrapWord
+	lbl.Resize(fyne.NewSize(plain.Width/2+16, 100))
+	if wrapped := renderer.MinSize(); wrapped.Height <= plain.Height+8 {
+		t.Error("Runs should wrap onto another line when the label is narrow")
+	}
+
+	lbl.SetText("Plain")
+	if len(renderer.Objects()) != 2 {
+		t.Error("SetText should go back to a single plain text")
+	}
+}
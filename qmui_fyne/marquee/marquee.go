@@ -3,6 +3,7 @@ package marquee
 
 import (
 	"image/color"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +22,15 @@ const (
 	MarqueeDirectionRight
 )
 
+// LoopGapAutomatic is a special LoopGap value that spaces repeats by the widget width
+const LoopGapAutomatic float32 = -1
+
+// TickerItem is an item scrolled by a ticker marquee
+type TickerItem struct {
+	Text  string
+	Color color.Color // nil uses the marquee's TextColor
+}
+
 // Marquee is a scrolling text label
 type Marquee struct {
 	widget.BaseWidget
@@ -36,6 +46,17 @@ type Marquee struct {
 	Speed         float32 // Pixels per second
 	PauseDuration time.Duration
 	FadeWidth     float32 // Width of fade effect at edges
+	LoopGap       float32 // Space before the text repeats, or LoopGapAutomatic
+
+	// RightToLeft anchors the text to the trailing edge and reverses the
+	// scroll direction, for Arabic and Hebrew tickers
+	RightToLeft bool
+
+	// Ticker mode. Items set with NewTicker or SetItems scroll in sequence
+	// in place of Text, each followed by Separator. OnItemVisible is called
+	// with the index of the item that scrolls into the center.
+	Separator     string
+	OnItemVisible func(index int)
 
 	// State
 	AutoScrollWhenFits bool // Only scroll if text doesn't fit
@@ -46,6 +67,8 @@ type Marquee struct {
 	textWidth    float32
 	animating    bool
 	stopChan     chan struct{}
+	items        []TickerItem
+	visibleItem  int
 }
 
 // NewMarquee creates a new marquee label
@@ -59,16 +82,40 @@ func NewMarquee(text string) *Marquee {
 		Speed:              30,
 		PauseDuration:      time.Second * 2,
 		FadeWidth:          10,
+		LoopGap:            LoopGapAutomatic,
+		Separator:          "  •  ",
 		AutoScrollWhenFits: true,
+		visibleItem:        -1,
 	}
 	ml.ExtendBaseWidget(ml)
 	return ml
 }
 
-// SetText sets the label text
+// NewTicker creates a marquee that scrolls items in sequence, separated by
+// Separator, like a stock ticker. The items follow on from each other
+// without a loop gap.
+func NewTicker(items []string) *Marquee {
+	ml := NewMarquee("")
+	ml.LoopGap = 0
+	ml.setItems(tickerItems(items))
+	return ml
+}
+
+// tickerItems wraps texts as items in the marquee's TextColor
+func tickerItems(texts []string) []TickerItem {
+	items := make([]TickerItem, len(texts))
+	for i, text := range texts {
+		items[i] = TickerItem{Text: text}
+	}
+	return items
+}
+
+// SetText sets the label text, leaving ticker mode
 func (ml *Marquee) SetText(text string) {
 	ml.mu.Lock()
 	ml.Text = text
+	ml.items = nil
+	ml.visibleItem = -1
 	ml.offset = 0
 	ml.mu.Unlock()
 	fyne.Do(func() {
@@ -76,6 +123,117 @@ func (ml *Marquee) SetText(text string) {
 	})
 }
 
+// SetItems sets the items scrolled in ticker mode
+func (ml *Marquee) SetItems(items []string) {
+	ml.SetTickerItems(tickerItems(items))
+}
+
+// SetTickerItems sets the items scrolled in ticker mode, each with an
+// optional color
+func (ml *Marquee) SetTickerItems(items []TickerItem) {
+	ml.setItems(items)
+	fyne.Do(func() {
+		ml.Refresh()
+	})
+}
+
+func (ml *Marquee) setItems(items []TickerItem) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	ml.items = append([]TickerItem(nil), items...)
+	ml.visibleItem = -1
+	ml.offset = 0
+
+	// Text holds the joined content, so it still measures and reads as one
+	texts := make([]string, len(items))
+	for i, item := range items {
+		texts[i] = item.Text
+	}
+	ml.Text = strings.Join(texts, ml.Separator)
+}
+
+// Items returns the items scrolled in ticker mode, or nil for a plain marquee
+func (ml *Marquee) Items() []TickerItem {
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+	return append([]TickerItem(nil), ml.items...)
+}
+
+// VisibleItem returns the index of the ticker item last scrolled into the
+// center, or -1 if there is none
+func (ml *Marquee) VisibleItem() int {
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+	return ml.visibleItem
+}
+
+// tickerSegments returns the items each followed by a separator, with
+// separators in TextColor; caller holds mu
+func (ml *Marquee) tickerSegments() []TickerItem {
+	segments := make([]TickerItem, 0, len(ml.items)*2)
+	for _, item := range ml.items {
+		if item.Color == nil {
+			item.Color = ml.TextColor
+		}
+		segments = append(segments, item, TickerItem{Text: ml.Separator, Color: ml.TextColor})
+	}
+	return segments
+}
+
+// setVisibleItem records the item in the center, notifying OnItemVisible
+// when it changes
+func (ml *Marquee) setVisibleItem(index int) {
+	ml.mu.Lock()
+	if index == ml.visibleItem {
+		ml.mu.Unlock()
+		return
+	}
+	ml.visibleItem = index
+	onItemVisible := ml.OnItemVisible
+	ml.mu.Unlock()
+
+	if onItemVisible != nil {
+		onItemVisible(index)
+	}
+}
+
+// SetLoopGap sets the space inserted before the text repeats
+func (ml *Marquee) SetLoopGap(gap float32) {
+	ml.mu.Lock()
+	ml.LoopGap = gap
+	ml.mu.Unlock()
+	ml.Refresh()
+}
+
+// SetRightToLeft sets whether the text is laid out and scrolled right-to-left
+func (ml *Marquee) SetRightToLeft(rtl bool) {
+	ml.mu.Lock()
+	ml.RightToLeft = rtl
+	ml.offset = 0
+	ml.mu.Unlock()
+	ml.Refresh()
+}
+
+// loopGap returns the effective gap between repeats; caller holds mu
+func (ml *Marquee) loopGap(containerWidth float32) float32 {
+	if ml.LoopGap < 0 {
+		return containerWidth
+	}
+	return ml.LoopGap
+}
+
+// scrollDirection returns Direction, reversed for right-to-left text; caller holds mu
+func (ml *Marquee) scrollDirection() MarqueeDirection {
+	if !ml.RightToLeft {
+		return ml.Direction
+	}
+	if ml.Direction == MarqueeDirectionLeft {
+		return MarqueeDirectionRight
+	}
+	return MarqueeDirectionLeft
+}
+
 // StartAnimation starts the marquee animation
 func (ml *Marquee) StartAnimation() {
 	ml.mu.Lock()
@@ -140,23 +298,22 @@ func (ml *Marquee) animate() {
 			}
 
 			speed := ml.Speed
-			direction := ml.Direction
-			textWidth := ml.textWidth
+			direction := ml.scrollDirection()
+			period := ml.textWidth + ml.loopGap(containerWidth)
 
 			// Calculate movement
 			delta := speed * 0.016 // 16ms tick
 
+			// Wrap once the clone has reached the starting position
 			if direction == MarqueeDirectionLeft {
 				ml.offset -= delta
-				// Reset when fully scrolled
-				if ml.offset < -textWidth {
-					ml.offset = containerWidth
+				if ml.offset <= -period {
+					ml.offset += period
 				}
 			} else {
 				ml.offset += delta
-				// Reset when fully scrolled
-				if ml.offset > containerWidth {
-					ml.offset = -textWidth
+				if ml.offset >= period {
+					ml.offset -= period
 				}
 			}
 
@@ -181,17 +338,30 @@ func (ml *Marquee) CreateRenderer() fyne.WidgetRenderer {
 	textClone.TextStyle = ml.TextStyle
 	textClone.TextSize = ml.TextSize
 
-	return &marqueeLabelRenderer{
+	r := &marqueeLabelRenderer{
 		label:     ml,
 		text:      text,
 		textClone: textClone,
 	}
+
+	ml.mu.RLock()
+	segments := ml.tickerSegments()
+	ml.mu.RUnlock()
+	r.updateSegments(segments)
+	text.Hidden = len(segments) > 0
+	textClone.Hidden = len(segments) > 0
+	return r
 }
 
 type marqueeLabelRenderer struct {
 	label     *Marquee
 	text      *canvas.Text
 	textClone *canvas.Text
+
+	// Ticker mode draws each item and separator on its own, so items can
+	// have their own colors. The first half are the text, the second half
+	// its clone.
+	segments []*canvas.Text
 }
 
 func (r *marqueeLabelRenderer) Destroy() {
@@ -199,17 +369,71 @@ func (r *marqueeLabelRenderer) Destroy() {
 }
 
 func (r *marqueeLabelRenderer) Layout(size fyne.Size) {
+	textWidth := r.text.MinSize().Width
+	if len(r.segments) > 0 {
+		textWidth = 0
+		for _, segment := range r.segments[:len(r.segments)/2] {
+			textWidth += segment.MinSize().Width
+		}
+	}
+
 	r.label.mu.Lock()
-	r.label.textWidth = r.text.MinSize().Width
+	r.label.textWidth = textWidth
+	r.label.mu.Unlock()
+
+	r.positionText(size)
+}
+
+// positionText places the text at the current offset and its clone one
+// loop period behind it
+func (r *marqueeLabelRenderer) positionText(size fyne.Size) {
+	r.label.mu.RLock()
 	offset := r.label.offset
 	textWidth := r.label.textWidth
-	r.label.mu.Unlock()
+	gap := r.label.loopGap(size.Width)
+	direction := r.label.scrollDirection()
+	rtl := r.label.RightToLeft
+	r.label.mu.RUnlock()
+
+	x := offset
+	if rtl {
+		x += size.Width - textWidth
+	}
+	period := textWidth + gap
+	if direction == MarqueeDirectionRight {
+		period = -period
+	}
 
 	textHeight := r.text.MinSize().Height
 	y := (size.Height - textHeight) / 2
 
-	r.text.Move(fyne.NewPos(offset, y))
-	r.textClone.Move(fyne.NewPos(offset+textWidth+50, y)) // Gap between copies
+	r.text.Move(fyne.NewPos(x, y))
+	r.textClone.Move(fyne.NewPos(x+period, y))
+
+	if len(r.segments) > 0 {
+		r.positionSegments(x, period, y, size.Width/2)
+	}
+}
+
+// positionSegments lays the ticker segments out from x, and their clones
+// from x+period, and reports the item under center as visible
+func (r *marqueeLabelRenderer) positionSegments(x, period, y, center float32) {
+	half := len(r.segments) / 2
+	visible := -1
+	for copyIndex, start := range []float32{x, x + period} {
+		segX := start
+		for i, segment := range r.segments[copyIndex*half : (copyIndex+1)*half] {
+			width := segment.MinSize().Width
+			segment.Move(fyne.NewPos(segX, y))
+			if i%2 == 0 && center >= segX && center < segX+width {
+				visible = i / 2
+			}
+			segX += width
+		}
+	}
+	if visible >= 0 {
+		r.label.setVisibleItem(visible)
+	}
 }
 
 func (r *marqueeLabelRenderer) MinSize() fyne.Size {
@@ -220,10 +444,14 @@ func (r *marqueeLabelRenderer) MinSize() fyne.Size {
 func (r *marqueeLabelRenderer) Refresh() {
 	r.label.mu.RLock()
 	text := r.label.Text
-	offset := r.label.offset
-	textWidth := r.label.textWidth
+	segments := r.label.tickerSegments()
 	r.label.mu.RUnlock()
 
+	r.updateSegments(segments)
+	ticker := len(segments) > 0
+	r.text.Hidden = ticker
+	r.textClone.Hidden = ticker
+
 	r.text.Text = text
 	r.text.Color = r.label.TextColor
 	r.text.TextStyle = r.label.TextStyle
@@ -235,16 +463,34 @@ func (r *marqueeLabelRenderer) Refresh() {
 	r.textClone.TextSize = r.label.TextSize
 
 	// Update text positions based on current offset (for animation)
-	textHeight := r.text.MinSize().Height
-	size := r.label.Size()
-	y := (size.Height - textHeight) / 2
-	r.text.Move(fyne.NewPos(offset, y))
-	r.textClone.Move(fyne.NewPos(offset+textWidth+50, y))
+	r.positionText(r.label.Size())
 
 	r.text.Refresh()
 	r.textClone.Refresh()
 }
 
+// updateSegments styles a text, and a clone, for each ticker segment
+func (r *marqueeLabelRenderer) updateSegments(segments []TickerItem) {
+	if len(r.segments) != len(segments)*2 {
+		r.segments = make([]*canvas.Text, len(segments)*2)
+		for i := range r.segments {
+			r.segments[i] = canvas.NewText("", r.label.TextColor)
+		}
+	}
+	for i, segment := range r.segments {
+		item := segments[i%len(segments)]
+		segment.Text = item.Text
+		segment.Color = item.Color
+		segment.TextStyle = r.label.TextStyle
+		segment.TextSize = r.label.TextSize
+		segment.Refresh()
+	}
+}
+
 func (r *marqueeLabelRenderer) Objects() []fyne.CanvasObject {
-	return []fyne.CanvasObject{r.text, r.textClone}
+	objects := []fyne.CanvasObject{r.text, r.textClone}
+	for _, segment := range r.segments {
+		objects = append(objects, segment)
+	}
+	return objects
 }
@@ -1,13 +1,26 @@
 package marquee
 
 import (
+	"image/color"
+	"sync"
 	"testing"
 	"time"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/test"
 )
 
+// waitFor polls cond until it holds or a second has passed, returning
+// whether it held
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for !cond() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return cond()
+}
+
 func TestMarqueeLabel_VisualPositionChanges(t *testing.T) {
 	// Create a marquee with long text
 	longText := "This is a very long marquee text that should definitely scroll because it is much wider than the container"
@@ -309,3 +322,128 @@ func TestMarqueeLabel_DiagnoseSizing(t *testing.T) {
 
 	w.Close()
 }
+
+func TestMarqueeLabel_LoopGap(t *testing.T) {
+	ml := NewMarquee("Looping marquee text")
+	w := test.NewWindow(ml)
+	w.Resize(fyne.NewSize(120, 40))
+	defer w.Close()
+
+	renderer := test.WidgetRenderer(ml)
+	objects := renderer.Objects()
+	renderer.Layout(ml.Size())
+
+	textWidth := objects[0].MinSize().Width
+	gap := objects[1].Position().X - objects[0].Position().X - textWidth
+	if gap != ml.Size().Width {
+		t.Errorf("Automatic loop gap should match widget width %f, got %f", ml.Size().Width, gap)
+	}
+
+	ml.SetLoopGap(30)
+	renderer.Layout(ml.Size())
+	gap = objects[1].Position().X - objects[0].Position().X - textWidth
+	if gap != 30 {
+		t.Errorf("Loop gap should be 30, got %f", gap)
+	}
+}
+
+func TestMarqueeLabel_RightToLeft(t *testing.T) {
+	longText := "نص طويل بما يكفي للتمرير داخل شريط الأخبار المتحرك"
+	ml := NewMarquee(longText)
+	ml.PauseDuration = 50 * time.Millisecond
+	ml.Speed = 100
+	ml.AutoScrollWhenFits = false
+	ml.SetRightToLeft(true)
+
+	w := test.NewWindow(ml)
+	w.Resize(fyne.NewSize(80, 40))
+	defer w.Close()
+
+	renderer := test.WidgetRenderer(ml)
+	objects := renderer.Objects()
+	renderer.Layout(ml.Size())
+
+	// Text is anchored to the trailing edge with its repeat to the left
+	textWidth := objects[0].MinSize().Width
+	if end := objects[0].Position().X + textWidth; end != ml.Size().Width {
+		t.Errorf("RTL text should end at the trailing edge %f, got %f", ml.Size().Width, end)
+	}
+	if objects[1].Position().X >= objects[0].Position().X {
+		t.Error("RTL repeat should be placed before the text")
+	}
+
+	ml.StartAnimation()
+	scrolled := waitFor(func() bool {
+		ml.mu.RLock()
+		defer ml.mu.RUnlock()
+		return ml.offset > 0
+	})
+	ml.StopAnimation()
+
+	if !scrolled {
+		t.Error("RTL text should scroll to the right")
+	}
+}
+
+func TestMarquee_Ticker(t *testing.T) {
+	ml := NewTicker([]string{"AAPL 189.2", "GOOG 141.8", "MSFT 411.6"})
+	ml.PauseDuration = 10 * time.Millisecond
+	ml.Speed = 2000
+	ml.AutoScrollWhenFits = false
+
+	var mu sync.Mutex
+	seen := map[int]bool{}
+	ml.OnItemVisible = func(index int) {
+		mu.Lock()
+		seen[index] = true
+		mu.Unlock()
+	}
+
+	w := test.NewWindow(ml)
+	w.Resize(fyne.NewSize(120, 40))
+	defer w.Close()
+
+	if ml.Text != "AAPL 189.2  •  GOOG 141.8  •  MSFT 411.6" {
+		t.Errorf("Ticker text should join the items, got %q", ml.Text)
+	}
+
+	ml.SetTickerItems([]TickerItem{
+		{Text: "UP 1.2%", Color: color.NRGBA{G: 200, A: 255}},
+		{Text: "DOWN 0.4%", Color: color.NRGBA{R: 200, A: 255}},
+	})
+	renderer := test.WidgetRenderer(ml)
+	renderer.Layout(ml.Size())
+	objects := renderer.Objects()
+
+	// Plain text and clone are hidden, then each item and separator twice
+	if len(objects) != 2+8 || objects[0].Visible() {
+		t.Fatalf("Ticker should draw item and separator segments, got %d objects", len(objects))
+	}
+	up, sep, down := objects[2].(*canvas.Text), objects[3].(*canvas.Text), objects[4].(*canvas.Text)
+	if up.Text != "UP 1.2%" || up.Color != (color.NRGBA{G: 200, A: 255}) || down.Color != (color.NRGBA{R: 200, A: 255}) {
+		t.Error("Ticker items should keep their own colors")
+	}
+	if sep.Text != ml.Separator || sep.Color != ml.TextColor {
+		t.Error("Separators should use the marquee text color")
+	}
+	if sep.Position().X != up.Position().X+up.MinSize().Width {
+		t.Error("Separator should follow its item")
+	}
+
+	ml.StartAnimation()
+	sawBoth := waitFor(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return seen[0] && seen[1]
+	})
+	ml.StopAnimation()
+
+	if !sawBoth {
+		t.Error("Both items should scroll through the center")
+	}
+
+	ml.SetText("Plain")
+	if ml.Items() != nil || ml.VisibleItem() != -1 || len(renderer.Objects()) != 2 || !objects[0].Visible() {
+		t.Error("SetText should leave ticker mode")
+	}
+}
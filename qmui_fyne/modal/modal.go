@@ -65,6 +65,8 @@ type Modal struct {
 
 	// Behavior
 	DismissOnTapOutside     bool
+	DismissOnDrag           bool // Drag the content away from its edge to dismiss
+	KeyboardAvoidance       bool // Move bottom content up while a field in it has focus
 	KeyboardFollowsContent  bool
 
 	// Callbacks
@@ -73,6 +75,7 @@ type Modal struct {
 	OnWillDismiss func()
 	OnDidDismiss  func()
 	OnTapOutside  func() bool // Return true to allow dismiss
+	ShouldDismiss func() bool // Return false to keep the modal open on a backdrop tap, drag or Escape
 
 	// State
 	mu          sync.RWMutex
@@ -82,6 +85,11 @@ type Modal struct {
 	animating   bool
 	dimmer      *canvas.Rectangle
 	contentWrapper fyne.CanvasObject
+	sheet       *modalSheet
+	dragging    bool
+	dragOffset  float32
+	restPos     fyne.Position
+	keyboard    *core.KeyboardAvoider
 }
 
 // NewModal creates a new modal presentation controller
@@ -101,6 +109,8 @@ func NewModal() *Modal {
 		ShadowOffset:      fyne.NewPos(0, 4),
 		ShadowRadius:      8,
 		DismissOnTapOutside: true,
+		DismissOnDrag:       true,
+		KeyboardAvoidance:   true,
 	}
 	mpvc.ExtendBaseWidget(mpvc)
 	return mpvc
@@ -129,6 +139,8 @@ func (mpvc *Modal) Present(window fyne.Window) {
 	}
 	mpvc.animating = true
 	mpvc.window = window
+	mpvc.dragging = false
+	mpvc.dragOffset = 0
 	mpvc.mu.Unlock()
 
 	if mpvc.OnWillPresent != nil {
@@ -139,13 +151,24 @@ func (mpvc *Modal) Present(window fyne.Window) {
 	content := mpvc.buildContent()
 	mpvc.popup = widget.NewModalPopUp(content, window.Canvas())
 	mpvc.popup.Resize(window.Canvas().Size())
-	mpvc.popup.Show()
+	core.SharedOverlayManager().Show(window.Canvas(), mpvc.popup, core.SharedConfiguration().WindowLevelQMUIModalPresentation)
+	core.SharedOverlayManager().SetDismissHandler(mpvc.popup, func() bool {
+		if !mpvc.DismissOnTapOutside {
+			return false
+		}
+		mpvc.dismissByUser()
+		return true
+	})
 
 	// Animate in
 	mpvc.animatePresent(func() {
 		mpvc.mu.Lock()
 		mpvc.visible = true
 		mpvc.animating = false
+		if mpvc.KeyboardAvoidance && mpvc.ContentPosition == ModalContentPositionBottom {
+			mpvc.keyboard = core.NewKeyboardAvoider(window.Canvas(), mpvc.contentWrapper)
+			mpvc.keyboard.Start()
+		}
 		mpvc.mu.Unlock()
 
 		if mpvc.OnDidPresent != nil {
@@ -162,8 +185,14 @@ func (mpvc *Modal) Dismiss() {
 		return
 	}
 	mpvc.animating = true
+	keyboard := mpvc.keyboard
+	mpvc.keyboard = nil
 	mpvc.mu.Unlock()
 
+	if keyboard != nil {
+		keyboard.Stop()
+	}
+
 	if mpvc.OnWillDismiss != nil {
 		mpvc.OnWillDismiss()
 	}
@@ -173,7 +202,7 @@ func (mpvc *Modal) Dismiss() {
 		fyne.Do(func() {
 			mpvc.mu.Lock()
 			if mpvc.popup != nil {
-				mpvc.popup.Hide()
+				core.SharedOverlayManager().Hide(mpvc.popup.Canvas, mpvc.popup)
 				mpvc.popup = nil
 			}
 			mpvc.visible = false
@@ -187,6 +216,118 @@ func (mpvc *Modal) Dismiss() {
 	})
 }
 
+// dismissByUser dismisses the modal for a backdrop tap, drag or Escape.
+// If ShouldDismiss cancels it, the content springs back instead.
+func (mpvc *Modal) dismissByUser() {
+	if mpvc.ShouldDismiss != nil && !mpvc.ShouldDismiss() {
+		mpvc.springBack()
+		return
+	}
+	mpvc.Dismiss()
+}
+
+func (mpvc *Modal) backdropTapped() {
+	if !mpvc.DismissOnTapOutside {
+		return
+	}
+	if mpvc.OnTapOutside != nil && !mpvc.OnTapOutside() {
+		return
+	}
+	mpvc.dismissByUser()
+}
+
+// dragDirection returns the direction the content is dragged to dismiss it,
+// away from the edge it is anchored to
+func (mpvc *Modal) dragDirection() (float32, float32) {
+	switch mpvc.ContentPosition {
+	case ModalContentPositionTop:
+		return 0, -1
+	case ModalContentPositionLeft:
+		return -1, 0
+	case ModalContentPositionRight:
+		return 1, 0
+	default:
+		return 0, 1
+	}
+}
+
+func (mpvc *Modal) dragged(ev *fyne.DragEvent) {
+	if !mpvc.DismissOnDrag || mpvc.contentWrapper == nil {
+		return
+	}
+
+	mpvc.mu.Lock()
+	if !mpvc.visible || mpvc.animating {
+		mpvc.mu.Unlock()
+		return
+	}
+	if !mpvc.dragging {
+		mpvc.dragging = true
+		if mpvc.dragOffset == 0 {
+			mpvc.restPos = mpvc.contentWrapper.Position()
+		}
+	}
+	dx, dy := mpvc.dragDirection()
+	mpvc.dragOffset += ev.Dragged.DX*dx + ev.Dragged.DY*dy
+	if mpvc.dragOffset < 0 {
+		mpvc.dragOffset = 0
+	}
+	pos := mpvc.restPos.Add(fyne.NewPos(mpvc.dragOffset*dx, mpvc.dragOffset*dy))
+	mpvc.mu.Unlock()
+
+	mpvc.contentWrapper.Move(pos)
+}
+
+// dragEnd dismisses the modal once the content is dragged a third of its
+// size, and springs it back otherwise
+func (mpvc *Modal) dragEnd() {
+	mpvc.mu.Lock()
+	if !mpvc.dragging {
+		mpvc.mu.Unlock()
+		return
+	}
+	mpvc.dragging = false
+	offset := mpvc.dragOffset
+	mpvc.mu.Unlock()
+
+	size := mpvc.sheet.Size()
+	distance := size.Height / 3
+	if dx, _ := mpvc.dragDirection(); dx != 0 {
+		distance = size.Width / 3
+	}
+
+	if offset >= distance {
+		mpvc.dismissByUser()
+	} else {
+		mpvc.springBack()
+	}
+}
+
+// springBack returns dragged content to where it rests
+func (mpvc *Modal) springBack() {
+	mpvc.mu.Lock()
+	offset := mpvc.dragOffset
+	rest := mpvc.restPos
+	mpvc.dragOffset = 0
+	mpvc.mu.Unlock()
+
+	if offset == 0 || mpvc.contentWrapper == nil {
+		return
+	}
+
+	from := mpvc.contentWrapper.Position()
+	animation.NewPositionAnimation(
+		float64(from.X), float64(from.Y), float64(rest.X), float64(rest.Y),
+		mpvc.AnimationDuration,
+		animation.Spring(8, 12),
+		func(x, y float64) {
+			if mpvc.contentWrapper != nil {
+				mpvc.contentWrapper.Move(fyne.NewPos(float32(x), float32(y)))
+			}
+		},
+	).Start()
+}
+
 // IsVisible returns whether the modal is visible
 func (mpvc *Modal) IsVisible() bool {
 	mpvc.mu.RLock()
@@ -218,14 +359,16 @@ func (mpvc *Modal) buildContent() fyne.CanvasObject {
 	}
 
 	// Position content
-	positioned := mpvc.positionContent(wrappedContent)
+	mpvc.sheet = newModalSheet(mpvc, wrappedContent)
+	positioned := mpvc.positionContent(mpvc.sheet)
 	mpvc.contentWrapper = positioned
 
 	// Stack everything
+	backdrop := newModalBackdrop(mpvc.dimmer, mpvc.backdropTapped)
 	if shadow != nil {
-		return container.NewStack(mpvc.dimmer, positioned)
+		return container.NewStack(backdrop, positioned)
 	}
-	return container.NewStack(mpvc.dimmer, positioned)
+	return container.NewStack(backdrop, positioned)
 }
 
 func (mpvc *Modal) positionContent(content fyne.CanvasObject) fyne.CanvasObject {
@@ -291,7 +434,7 @@ func (mpvc *Modal) animateFadeIn(onComplete func()) {
 	// Fyne doesn't directly support opacity animation, so we just complete
 	if onComplete != nil {
 		go func() {
-			time.Sleep(mpvc.AnimationDuration)
+			time.Sleep(core.MotionDuration(mpvc.AnimationDuration))
 			onComplete()
 		}()
 	}
@@ -300,7 +443,7 @@ func (mpvc *Modal) animateFadeIn(onComplete func()) {
 func (mpvc *Modal) animateFadeOut(onComplete func()) {
 	if onComplete != nil {
 		go func() {
-			time.Sleep(mpvc.AnimationDuration)
+			time.Sleep(core.MotionDuration(mpvc.AnimationDuration))
 			onComplete()
 		}()
 	}
@@ -348,7 +491,7 @@ func (mpvc *Modal) animateSlideIn(dirX, dirY float64, onComplete func()) {
 
 	if onComplete != nil {
 		go func() {
-			time.Sleep(mpvc.AnimationDuration)
+			time.Sleep(core.MotionDuration(mpvc.AnimationDuration))
 			onComplete()
 		}()
 	}
@@ -393,7 +536,7 @@ func (mpvc *Modal) animateSlideOut(dirX, dirY float64, onComplete func()) {
 
 	if onComplete != nil {
 		go func() {
-			time.Sleep(mpvc.AnimationDuration)
+			time.Sleep(core.MotionDuration(mpvc.AnimationDuration))
 			onComplete()
 		}()
 	}
@@ -441,12 +584,70 @@ func (mpvc *Modal) animateBounceIn(onComplete func()) {
 
 	if onComplete != nil {
 		go func() {
-			time.Sleep(mpvc.AnimationDuration * 2)
+			time.Sleep(core.MotionDuration(mpvc.AnimationDuration * 2))
 			onComplete()
 		}()
 	}
 }
 
+// modalBackdrop is the dimmed area around the content, which dismisses the
+// modal when tapped
+type modalBackdrop struct {
+	widget.BaseWidget
+	dimmer   *canvas.Rectangle
+	onTapped func()
+}
+
+func newModalBackdrop(dimmer *canvas.Rectangle, onTapped func()) *modalBackdrop {
+	b := &modalBackdrop{dimmer: dimmer, onTapped: onTapped}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// Tapped implements fyne.Tappable
+func (b *modalBackdrop) Tapped(_ *fyne.PointEvent) {
+	if b.onTapped != nil {
+		b.onTapped()
+	}
+}
+
+// CreateRenderer implements fyne.Widget
+func (b *modalBackdrop) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(b.dimmer)
+}
+
+// modalSheet holds the content, keeping taps on it from reaching the
+// backdrop and dragging it to dismiss
+type modalSheet struct {
+	widget.BaseWidget
+	modal   *Modal
+	content fyne.CanvasObject
+}
+
+func newModalSheet(modal *Modal, content fyne.CanvasObject) *modalSheet {
+	s := &modalSheet{modal: modal, content: content}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// Tapped implements fyne.Tappable
+func (s *modalSheet) Tapped(_ *fyne.PointEvent) {}
+
+// Dragged implements fyne.Draggable
+func (s *modalSheet) Dragged(ev *fyne.DragEvent) {
+	s.modal.dragged(ev)
+}
+
+// DragEnd implements fyne.Draggable
+func (s *modalSheet) DragEnd() {
+	s.modal.dragEnd()
+}
+
+// CreateRenderer implements fyne.Widget
+func (s *modalSheet) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(s.content)
+}
+
 // CreateRenderer implements fyne.Widget
 func (mpvc *Modal) CreateRenderer() fyne.WidgetRenderer {
 	mpvc.ExtendBaseWidget(mpvc)
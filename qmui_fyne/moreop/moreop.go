@@ -3,6 +3,7 @@ package moreop
 
 import (
 	"image/color"
+	"math"
 	"sync"
 	"time"
 
@@ -14,16 +15,19 @@ import (
 
 	"github.com/paul-hammant/qmui_fyne/animation"
 	"github.com/paul-hammant/qmui_fyne/core"
+	"github.com/paul-hammant/qmui_fyne/modal"
 )
 
-// Item represents an item in the operation sheet
+// Item represents an item in the operation sheet. Disabled items are
+// dimmed and ignore taps; destructive items show their title in red.
 type Item struct {
 	Identifier  string
 	Title       string
 	Icon        fyne.Resource
 	Handler     func(item *Item)
 	Tag         int
-	IsEnabled   bool
+	Enabled     bool
+	Destructive bool
 	ShowsBadge  bool
 	BadgeValue  string
 }
@@ -35,12 +39,14 @@ func NewItem(identifier, title string, icon fyne.Resource, handler func(*Item))
 		Title:      title,
 		Icon:       icon,
 		Handler:    handler,
-		IsEnabled:  true,
+		Enabled:    true,
 	}
 }
 
-// ItemGroup represents a group/row of items
+// ItemGroup represents a group/row of items, with an optional section
+// title shown above them
 type ItemGroup struct {
+	Title string
 	Items []*Item
 }
 
@@ -49,6 +55,11 @@ func NewItemGroup(items ...*Item) *ItemGroup {
 	return &ItemGroup{Items: items}
 }
 
+// NewTitledItemGroup creates a new item group with a section title
+func NewTitledItemGroup(title string, items ...*Item) *ItemGroup {
+	return &ItemGroup{Title: title, Items: items}
+}
+
 // ActionSheet manages a grid-style bottom sheet
 type ActionSheet struct {
 	// Content
@@ -70,12 +81,18 @@ type ActionSheet struct {
 	ItemHighlightColor     color.Color
 	ItemTitleColor         color.Color
 	ItemTitleHighlightColor color.Color
+	ItemDestructiveTitleColor color.Color
+	SectionTitleColor      color.Color
+	SectionTitleFontSize   float32
 	SeparatorColor         color.Color
 	CancelButtonColor      color.Color
 	DimmingColor           color.Color
 	CornerRadius           float32
 
-	// Animation
+	// Animation. The sheet slides up from the bottom edge for every slide
+	// style and slides back down when dismissed; Fade and Zoom only fade
+	// the dimming in and out.
+	AnimationStyle    modal.ModalAnimationStyle
 	AnimationDuration time.Duration
 
 	// Behavior
@@ -92,6 +109,14 @@ type ActionSheet struct {
 	window  fyne.Window
 	popup   *widget.PopUp
 	visible bool
+
+	// Presentation state: the running transition and how far the sheet is
+	// shown, from 0 to 1
+	content    fyne.CanvasObject
+	dimmer     *tappableDimmer
+	transition *animation.Animation
+	shown      float64
+	dismissing bool
 }
 
 // NewActionSheet creates a new operation controller
@@ -111,10 +136,14 @@ func NewActionSheet() *ActionSheet {
 		ItemHighlightColor:    config.SheetButtonHighlightBackgroundColor,
 		ItemTitleColor:        config.TableViewCellTitleLabelColor,
 		ItemTitleHighlightColor: config.BlueColor,
+		ItemDestructiveTitleColor: config.RedColor,
+		SectionTitleColor:     config.GrayColor,
+		SectionTitleFontSize:  13,
 		SeparatorColor:        config.SeparatorColor,
 		CancelButtonColor:     config.BlueColor,
 		DimmingColor:          config.MaskDarkColor,
 		CornerRadius:          config.SheetContentCornerRadius,
+		AnimationStyle:        modal.ModalAnimationStyleSlideUp,
 		AnimationDuration:     time.Millisecond * 300,
 		DismissOnTapOutside:   true,
 		DismissOnItemSelected: true,
@@ -141,11 +170,16 @@ func (moc *ActionSheet) SetCancelButton(title string, handler func(*Item)) {
 		Identifier: "cancel",
 		Title:      title,
 		Handler:    handler,
-		IsEnabled:  true,
+		Enabled:    true,
 	}
 	moc.mu.Unlock()
 }
 
+// AddItemsWithTitle adds items as a new group under a section title
+func (moc *ActionSheet) AddItemsWithTitle(title string, items ...*Item) {
+	moc.AddItemGroup(NewTitledItemGroup(title, items...))
+}
+
 // Show displays the operation sheet
 func (moc *ActionSheet) Show(window fyne.Window) {
 	moc.mu.Lock()
@@ -154,6 +188,8 @@ func (moc *ActionSheet) Show(window fyne.Window) {
 		return
 	}
 	moc.visible = true
+	moc.dismissing = false
+	moc.shown = 0
 	moc.window = window
 	moc.mu.Unlock()
 
@@ -176,36 +212,65 @@ func (moc *ActionSheet) Show(window fyne.Window) {
 
 	moc.popup = widget.NewModalPopUp(fullContent, window.Canvas())
 	moc.popup.Resize(window.Canvas().Size())
+	moc.content = content
+	moc.dimmer = dimmer
+	moc.applyTransition(0)
+	core.SharedOverlayManager().Show(window.Canvas(), moc.popup, core.SharedConfiguration().WindowLevelQMUIModalPresentation)
+	core.SharedOverlayManager().SetDismissHandler(moc.popup, moc.cancel)
 
 	// Animate in
-	moc.animateShow(content)
+	moc.animateShow()
 
 	if moc.OnShow != nil {
 		moc.OnShow()
 	}
 }
 
-// Dismiss hides the operation sheet
+// cancel performs the cancel button, or dismisses the sheet if it can be
+// dismissed by tapping outside, as pressing Escape does
+func (moc *ActionSheet) cancel() bool {
+	if item := moc.CancelButton; item != nil {
+		if item.Handler != nil {
+			item.Handler(item)
+		}
+		moc.Dismiss()
+		return true
+	}
+	if moc.DismissOnTapOutside {
+		moc.Dismiss()
+		return true
+	}
+	return false
+}
+
+// Dismiss hides the operation sheet. Dismissing while the sheet is still
+// animating in reverses it from where it is.
 func (moc *ActionSheet) Dismiss() {
 	moc.mu.Lock()
-	if !moc.visible {
+	if !moc.visible || moc.dismissing {
 		moc.mu.Unlock()
 		return
 	}
+	moc.dismissing = true
 	moc.mu.Unlock()
 
 	moc.animateHide(func() {
-		moc.mu.Lock()
-		if moc.popup != nil {
-			moc.popup.Hide()
-			moc.popup = nil
-		}
-		moc.visible = false
-		moc.mu.Unlock()
-
-		if moc.OnDismiss != nil {
-			moc.OnDismiss()
-		}
+		fyne.Do(func() {
+			moc.mu.Lock()
+			if moc.popup != nil {
+				core.SharedOverlayManager().Hide(moc.popup.Canvas, moc.popup)
+				moc.popup = nil
+			}
+			moc.content = nil
+			moc.dimmer = nil
+			moc.visible = false
+			moc.dismissing = false
+			moc.mu.Unlock()
+
+			if moc.OnDismiss != nil {
+				moc.OnDismiss()
+			}
+		})
 	})
 }
 
@@ -216,29 +281,67 @@ func (moc *ActionSheet) IsVisible() bool {
 	return moc.visible
 }
 
-func (moc *ActionSheet) animateShow(content fyne.CanvasObject) {
-	// Slide up animation
-	if moc.window == nil {
-		return
-	}
+// IsAnimating returns whether the sheet is animating in or out
+func (moc *ActionSheet) IsAnimating() bool {
+	moc.mu.RLock()
+	defer moc.mu.RUnlock()
+	return moc.transition != nil && moc.transition.IsRunning()
+}
 
-	canvasSize := moc.window.Canvas().Size()
-	contentSize := content.MinSize()
+func (moc *ActionSheet) animateShow() {
+	moc.runTransition(1, animation.EaseOutCubic, nil)
+}
 
-	startY := float64(canvasSize.Height)
-	endY := float64(canvasSize.Height - contentSize.Height)
+func (moc *ActionSheet) animateHide(onComplete func()) {
+	moc.runTransition(0, animation.EaseInCubic, onComplete)
+}
 
-	animation.NewPropertyAnimation(startY, endY, moc.AnimationDuration, animation.EaseOutCubic, func(y float64) {
-		content.Move(fyne.NewPos(0, float32(y)))
-	}).Start()
+// runTransition animates the sheet from how far it is shown to target,
+// stopping any transition still running, and calls onComplete at the end
+func (moc *ActionSheet) runTransition(target float64, easing animation.EasingFunction, onComplete func()) {
+	moc.mu.Lock()
+	if moc.transition != nil {
+		moc.transition.Stop()
+	}
+	from := moc.shown
+	duration := time.Duration(float64(moc.AnimationDuration) * math.Abs(target-from))
+	anim := animation.NewAnimation(duration, easing, func(progress float64) {
+		value := from + (target-from)*progress
+		fyne.Do(func() { moc.applyTransition(value) })
+	})
+	anim.OnComplete = onComplete
+	moc.transition = anim
+	moc.mu.Unlock()
+
+	anim.Start()
 }
 
-func (moc *ActionSheet) animateHide(onComplete func()) {
-	if onComplete != nil {
-		go func() {
-			time.Sleep(moc.AnimationDuration)
-			onComplete()
-		}()
+// applyTransition shows the sheet value of the way, fading the dimming and,
+// for slide styles, sliding the content up from the bottom edge
+func (moc *ActionSheet) applyTransition(value float64) {
+	moc.mu.Lock()
+	moc.shown = value
+	content := moc.content
+	dimmer := moc.dimmer
+	window := moc.window
+	moc.mu.Unlock()
+
+	if dimmer != nil {
+		_, _, _, a := moc.DimmingColor.RGBA()
+		dimmer.color = core.ColorWithAlpha(moc.DimmingColor, float64(a)/0xffff*value)
+		dimmer.Refresh()
+	}
+	if content == nil || window == nil {
+		return
+	}
+
+	canvasHeight := window.Canvas().Size().Height
+	contentHeight := content.MinSize().Height
+	switch moc.AnimationStyle {
+	case modal.ModalAnimationStyleFade, modal.ModalAnimationStyleZoom:
+		content.Move(fyne.NewPos(0, canvasHeight-contentHeight))
+	default:
+		content.Move(fyne.NewPos(0, canvasHeight-contentHeight*float32(value)))
 	}
 }
 
@@ -286,6 +389,12 @@ func (moc *ActionSheet) buildContent() fyne.CanvasObject {
 func (moc *ActionSheet) buildItemGroup(group *ItemGroup) fyne.CanvasObject {
 	var rows []fyne.CanvasObject
 
+	if group.Title != "" {
+		title := canvas.NewText(group.Title, moc.SectionTitleColor)
+		title.TextSize = moc.SectionTitleFontSize
+		rows = append(rows, container.NewPadded(title))
+	}
+
 	// Split items into rows based on ItemsPerRow
 	for i := 0; i < len(group.Items); i += moc.ItemsPerRow {
 		end := i + moc.ItemsPerRow
@@ -372,16 +481,18 @@ func (w *operationItemWidget) CreateRenderer() fyne.WidgetRenderer {
 	title.TextSize = w.controller.ItemTitleFontSize
 	title.Alignment = fyne.TextAlignCenter
 
-	return &operationItemRenderer{
+	r := &operationItemRenderer{
 		widget: w,
 		bg:     bg,
 		icon:   icon,
 		title:  title,
 	}
+	r.Refresh()
+	return r
 }
 
 func (w *operationItemWidget) Tapped(*fyne.PointEvent) {
-	if w.item.IsEnabled {
+	if w.item.Enabled {
 		w.controller.selectItem(w.item)
 	}
 }
@@ -405,7 +516,7 @@ func (w *operationItemWidget) MouseOut() {
 }
 
 func (w *operationItemWidget) Cursor() desktop.Cursor {
-	if w.item.IsEnabled {
+	if w.item.Enabled {
 		return desktop.PointerCursor
 	}
 	return desktop.DefaultCursor
@@ -447,7 +558,7 @@ func (r *operationItemRenderer) Refresh() {
 	hovered := r.widget.hovered
 	r.widget.mu.RUnlock()
 
-	if hovered && r.widget.item.IsEnabled {
+	if hovered && r.widget.item.Enabled {
 		r.bg.FillColor = r.widget.controller.ItemHighlightColor
 		r.title.Color = r.widget.controller.ItemTitleHighlightColor
 	} else {
@@ -455,13 +566,21 @@ func (r *operationItemRenderer) Refresh() {
 		r.title.Color = r.widget.controller.ItemTitleColor
 	}
 
-	if !r.widget.item.IsEnabled {
+	if r.widget.item.Destructive {
+		r.title.Color = r.widget.controller.ItemDestructiveTitleColor
+	}
+
+	if !r.widget.item.Enabled {
 		r.title.Color = core.SharedConfiguration().DisabledColor
 	}
 
 	r.bg.Refresh()
 	r.title.Refresh()
 	if r.icon != nil {
+		r.icon.Translucency = 0
+		if !r.widget.item.Enabled {
+			r.icon.Translucency = 1 - core.SharedConfiguration().ControlDisabledAlpha
+		}
 		r.icon.Refresh()
 	}
 }
@@ -0,0 +1,370 @@
+package navigation
+
+import (
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/paul-hammant/qmui_fyne/animation"
+	"github.com/paul-hammant/qmui_fyne/core"
+)
+
+const (
+	// interactivePopEdgeWidth is how close to the left edge a back-swipe
+	// must start
+	interactivePopEdgeWidth float32 = 20
+
+	// interactivePopThreshold is the fraction of the width a back-swipe
+	// must pass for the pop to complete when released
+	interactivePopThreshold float32 = 0.5
+
+	// navigationTransitionDuration is how long a released back-swipe takes
+	// to finish or spring back by default
+	navigationTransitionDuration = 250 * time.Millisecond
+)
+
+// NavigationController shows a navigation bar above a stack of screens.
+// Push shows a new screen, with a back button on the bar, and Pop returns to
+// the screen below it.
+type NavigationController struct {
+	widget.BaseWidget
+
+	// Bar shows the title of the top screen and its back button
+	Bar *NavigationBar
+
+	// InteractivePopEnabled lets the user drag from the left edge to slide
+	// the top screen off and reveal the previous one. Released past half the
+	// width the screen is popped, otherwise it springs back.
+	InteractivePopEnabled bool
+
+	// TransitionDuration is how long a released back-swipe takes to finish
+	// or spring back; 0 finishes it at once
+	TransitionDuration time.Duration
+
+	// OnPopped is called with the screen removed by Pop or a back-swipe
+	OnPopped func(screen fyne.CanvasObject)
+
+	mu         sync.RWMutex
+	stack      []navigationScreen
+	swipe      float32 // How far the top screen is dragged to the right
+	anim       *animation.Animation
+	edge       *interactivePopEdge
+	backButton *widget.Button
+}
+
+// navigationScreen is a screen on the navigation stack
+type navigationScreen struct {
+	title   *TitleView
+	content fyne.CanvasObject
+}
+
+// NewNavigationController creates a navigation controller showing root
+func NewNavigationController(title string, root fyne.CanvasObject) *NavigationController {
+	nc := &NavigationController{
+		Bar:                   NewNavigationBar(),
+		InteractivePopEnabled: true,
+		TransitionDuration:    navigationTransitionDuration,
+	}
+	nc.edge = &interactivePopEdge{controller: nc}
+	nc.edge.ExtendBaseWidget(nc.edge)
+	nc.backButton = widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		nc.Pop()
+	})
+	nc.backButton.Importance = widget.LowImportance
+	nc.stack = []navigationScreen{{title: NewTitleViewWithTitle(title), content: root}}
+	nc.updateBar()
+	nc.ExtendBaseWidget(nc)
+	return nc
+}
+
+// Push shows content above the current screen
+func (nc *NavigationController) Push(title string, content fyne.CanvasObject) {
+	nc.stopSwipe()
+	nc.mu.Lock()
+	nc.stack = append(nc.stack, navigationScreen{title: NewTitleViewWithTitle(title), content: content})
+	nc.swipe = 0
+	nc.mu.Unlock()
+
+	nc.updateBar()
+	nc.Refresh()
+}
+
+// Pop removes the top screen and returns it, showing the one below. The
+// root screen is never popped, so Pop returns nil when it is on top.
+func (nc *NavigationController) Pop() fyne.CanvasObject {
+	nc.stopSwipe()
+	nc.mu.Lock()
+	if len(nc.stack) < 2 {
+		nc.mu.Unlock()
+		return nil
+	}
+	top := nc.stack[len(nc.stack)-1].content
+	nc.stack = nc.stack[:len(nc.stack)-1]
+	nc.swipe = 0
+	onPopped := nc.OnPopped
+	nc.mu.Unlock()
+
+	nc.updateBar()
+	nc.Refresh()
+	if onPopped != nil {
+		onPopped(top)
+	}
+	return top
+}
+
+// TopScreen returns the screen currently shown
+func (nc *NavigationController) TopScreen() fyne.CanvasObject {
+	nc.mu.RLock()
+	defer nc.mu.RUnlock()
+	return nc.stack[len(nc.stack)-1].content
+}
+
+// Depth returns how many screens are on the stack, including the root
+func (nc *NavigationController) Depth() int {
+	nc.mu.RLock()
+	defer nc.mu.RUnlock()
+	return len(nc.stack)
+}
+
+// updateBar shows the top screen's title, with a back button when there is
+// a screen to go back to
+func (nc *NavigationController) updateBar() {
+	nc.mu.RLock()
+	title := nc.stack[len(nc.stack)-1].title
+	canPop := len(nc.stack) > 1
+	nc.mu.RUnlock()
+
+	nc.Bar.SetTitleView(title)
+	if canPop {
+		nc.Bar.SetLeftBarItems([]fyne.CanvasObject{nc.backButton})
+	} else {
+		nc.Bar.SetLeftBarItems(nil)
+	}
+}
+
+// canSwipe returns whether a back-swipe can pop the top screen
+func (nc *NavigationController) canSwipe() bool {
+	nc.mu.RLock()
+	defer nc.mu.RUnlock()
+	return nc.InteractivePopEnabled && len(nc.stack) > 1
+}
+
+// swipeDragged moves the top screen along with a back-swipe
+func (nc *NavigationController) swipeDragged(ev *fyne.DragEvent) {
+	if !nc.canSwipe() {
+		return
+	}
+	nc.stopSwipe()
+
+	width := nc.Size().Width
+	nc.mu.Lock()
+	nc.swipe += ev.Dragged.DX
+	if nc.swipe < 0 {
+		nc.swipe = 0
+	}
+	if nc.swipe > width {
+		nc.swipe = width
+	}
+	nc.mu.Unlock()
+	nc.Refresh()
+}
+
+// swipeEnded completes the pop if the top screen was dragged past the
+// threshold, and springs it back otherwise
+func (nc *NavigationController) swipeEnded() {
+	nc.mu.RLock()
+	swipe := nc.swipe
+	nc.mu.RUnlock()
+	if swipe <= 0 {
+		return
+	}
+
+	width := nc.Size().Width
+	if swipe >= width*interactivePopThreshold {
+		nc.animateSwipe(width, func() {
+			nc.Pop()
+		})
+		return
+	}
+	nc.animateSwipe(0, nil)
+}
+
+// animateSwipe slides the top screen to offset, then calls done
+func (nc *NavigationController) animateSwipe(offset float32, done func()) {
+	nc.mu.Lock()
+	from := nc.swipe
+	duration := nc.TransitionDuration
+	nc.mu.Unlock()
+
+	finish := func() {
+		nc.mu.Lock()
+		nc.swipe = offset
+		nc.anim = nil
+		nc.mu.Unlock()
+		nc.Refresh()
+		if done != nil {
+			done()
+		}
+	}
+	if duration <= 0 {
+		finish()
+		return
+	}
+
+	var anim *animation.Animation
+	anim = animation.NewAnimation(duration, animation.EaseOutCubic, func(progress float64) {
+		fyne.Do(func() {
+			nc.mu.Lock()
+			if nc.anim != anim {
+				nc.mu.Unlock()
+				return
+			}
+			nc.swipe = from + (offset-from)*float32(progress)
+			nc.mu.Unlock()
+			nc.Refresh()
+		})
+	})
+	anim.OnComplete = func() {
+		fyne.Do(func() {
+			nc.mu.RLock()
+			current := nc.anim == anim
+			nc.mu.RUnlock()
+			if current {
+				finish()
+			}
+		})
+	}
+
+	nc.mu.Lock()
+	nc.anim = anim
+	nc.mu.Unlock()
+	anim.Start()
+}
+
+// stopSwipe stops a running back-swipe animation where it is
+func (nc *NavigationController) stopSwipe() {
+	nc.mu.Lock()
+	anim := nc.anim
+	nc.anim = nil
+	nc.mu.Unlock()
+	if anim != nil {
+		anim.Stop()
+	}
+}
+
+// CreateRenderer implements fyne.Widget
+func (nc *NavigationController) CreateRenderer() fyne.WidgetRenderer {
+	nc.ExtendBaseWidget(nc)
+	return &navigationControllerRenderer{
+		controller: nc,
+		background: canvas.NewRectangle(core.SharedConfiguration().BackgroundColor),
+		shadow:     canvas.NewRectangle(core.ColorWithAlpha(core.SharedConfiguration().BlackColor, 0.15)),
+	}
+}
+
+type navigationControllerRenderer struct {
+	controller *NavigationController
+	background *canvas.Rectangle
+	shadow     *canvas.Rectangle
+}
+
+func (r *navigationControllerRenderer) Destroy() {}
+
+// screens returns the top screen, the one below it while a back-swipe
+// reveals it, and how far the top screen is dragged
+func (r *navigationControllerRenderer) screens() (top, previous fyne.CanvasObject, swipe float32) {
+	nc := r.controller
+	nc.mu.RLock()
+	defer nc.mu.RUnlock()
+	top = nc.stack[len(nc.stack)-1].content
+	if nc.swipe > 0 && len(nc.stack) > 1 {
+		previous = nc.stack[len(nc.stack)-2].content
+	}
+	return top, previous, nc.swipe
+}
+
+func (r *navigationControllerRenderer) Layout(size fyne.Size) {
+	barHeight := r.controller.Bar.MinSize().Height
+	r.controller.Bar.Resize(fyne.NewSize(size.Width, barHeight))
+	r.controller.Bar.Move(fyne.NewPos(0, 0))
+
+	contentSize := fyne.NewSize(size.Width, size.Height-barHeight)
+	top, previous, swipe := r.screens()
+
+	// The previous screen follows a third of the way behind the top one
+	if previous != nil {
+		previous.Resize(contentSize)
+		previous.Move(fyne.NewPos((swipe-size.Width)/3, barHeight))
+	}
+	// The background keeps the previous screen from showing through the top
+	r.background.Resize(contentSize)
+	r.background.Move(fyne.NewPos(swipe, barHeight))
+	top.Resize(contentSize)
+	top.Move(fyne.NewPos(swipe, barHeight))
+
+	r.shadow.Resize(fyne.NewSize(1, contentSize.Height))
+	r.shadow.Move(fyne.NewPos(swipe-1, barHeight))
+
+	r.controller.edge.Resize(fyne.NewSize(interactivePopEdgeWidth, contentSize.Height))
+	r.controller.edge.Move(fyne.NewPos(0, barHeight))
+}
+
+func (r *navigationControllerRenderer) MinSize() fyne.Size {
+	top, _, _ := r.screens()
+	barSize := r.controller.Bar.MinSize()
+	contentSize := top.MinSize()
+	return fyne.NewSize(fyne.Max(barSize.Width, contentSize.Width), barSize.Height+contentSize.Height)
+}
+
+func (r *navigationControllerRenderer) Refresh() {
+	config := core.SharedConfiguration()
+	r.background.FillColor = config.BackgroundColor
+	r.background.Refresh()
+	r.shadow.FillColor = core.ColorWithAlpha(config.BlackColor, 0.15)
+	r.shadow.Refresh()
+	r.Layout(r.controller.Size())
+}
+
+func (r *navigationControllerRenderer) Objects() []fyne.CanvasObject {
+	top, previous, swipe := r.screens()
+
+	objects := make([]fyne.CanvasObject, 0, 6)
+	if previous != nil {
+		objects = append(objects, previous)
+	}
+	objects = append(objects, r.background, top)
+	if swipe > 0 {
+		objects = append(objects, r.shadow)
+	}
+	// The edge sits above the screen so it receives drags starting there
+	if r.controller.canSwipe() {
+		objects = append(objects, r.controller.edge)
+	}
+	return append(objects, r.controller.Bar)
+}
+
+// interactivePopEdge is the strip along the left edge that starts a
+// back-swipe. It only handles drags, so taps reach the screen below it.
+type interactivePopEdge struct {
+	widget.BaseWidget
+	controller *NavigationController
+}
+
+// Dragged implements fyne.Draggable
+func (e *interactivePopEdge) Dragged(ev *fyne.DragEvent) {
+	e.controller.swipeDragged(ev)
+}
+
+// DragEnd implements fyne.Draggable
+func (e *interactivePopEdge) DragEnd() {
+	e.controller.swipeEnded()
+}
+
+func (e *interactivePopEdge) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
@@ -4,13 +4,18 @@ package navigation
 import (
 	"image/color"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/driver/mobile"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
+	"github.com/paul-hammant/qmui_fyne/popup"
+	"github.com/paul-hammant/qmui_fyne/toast"
 )
 
 // TitleViewStyle defines the style of the title view
@@ -42,11 +47,17 @@ type TitleView struct {
 	HorizontalAlignment  fyne.TextAlign
 	SpacingBetweenTitleAndSubtitle float32
 
-	// Accessory
+	// Accessory, drawn after the title. AccessoryView, when set, replaces
+	// the indicator drawn for AccessoryType.
 	AccessoryView        fyne.CanvasObject
 	AccessoryType        AccessoryType
 	AccessorySpacing     float32
 
+	// Expanded is whether the dropdown the title opens is showing. Tapping
+	// a title with OnTapped set toggles it, turning the disclosure
+	// indicator to point up while expanded.
+	Expanded bool
+
 	// Behavior
 	NeedsLoadingView     bool
 	UserInteractionEnabled bool
@@ -121,6 +132,21 @@ func (ntv *TitleView) SetSubtitle(subtitle string) {
 	ntv.Refresh()
 }
 
+// SetExpanded sets whether the title's dropdown is showing
+func (ntv *TitleView) SetExpanded(expanded bool) {
+	ntv.mu.Lock()
+	ntv.Expanded = expanded
+	ntv.mu.Unlock()
+	ntv.Refresh()
+}
+
+// IsExpanded returns whether the title's dropdown is showing
+func (ntv *TitleView) IsExpanded() bool {
+	ntv.mu.RLock()
+	defer ntv.mu.RUnlock()
+	return ntv.Expanded
+}
+
 // SetLoading sets the loading state
 func (ntv *TitleView) SetLoading(loading bool) {
 	ntv.mu.Lock()
@@ -146,22 +172,27 @@ func (ntv *TitleView) CreateRenderer() fyne.WidgetRenderer {
 	loading := widget.NewProgressBarInfinite()
 	loading.Hide()
 
-	return &navigationTitleRenderer{
+	disclosure := canvas.NewImageFromResource(theme.MenuDropDownIcon())
+	disclosure.FillMode = canvas.ImageFillContain
+
+	r := &navigationTitleRenderer{
 		titleView:     ntv,
 		titleLabel:    titleLabel,
 		subtitleLabel: subtitleLabel,
 		loading:       loading,
+		disclosure:    disclosure,
 	}
+	r.Refresh()
+	return r
 }
 
 // Tapped handles tap events
 func (ntv *TitleView) Tapped(_ *fyne.PointEvent) {
-	if !ntv.UserInteractionEnabled {
+	if !ntv.UserInteractionEnabled || ntv.OnTapped == nil {
 		return
 	}
-	if ntv.OnTapped != nil {
-		ntv.OnTapped()
-	}
+	ntv.SetExpanded(!ntv.IsExpanded())
+	ntv.OnTapped()
 }
 
 // TappedSecondary handles secondary tap
@@ -179,6 +210,7 @@ type navigationTitleRenderer struct {
 	titleLabel    *canvas.Text
 	subtitleLabel *canvas.Text
 	loading       *widget.ProgressBarInfinite
+	disclosure    *canvas.Image
 }
 
 func (r *navigationTitleRenderer) Destroy() {}
@@ -195,14 +227,12 @@ func (r *navigationTitleRenderer) Layout(size fyne.Size) {
 	if hasSubtitle {
 		totalHeight := titleSize.Height + subtitleSize.Height + spacing
 		startY := (size.Height - totalHeight) / 2
-		r.titleLabel.Move(fyne.NewPos(0, startY))
-		r.titleLabel.Resize(fyne.NewSize(size.Width, titleSize.Height))
+		r.layoutTitle(size.Width, startY)
 		r.subtitleLabel.Move(fyne.NewPos(0, startY+titleSize.Height+spacing))
 		r.subtitleLabel.Resize(fyne.NewSize(size.Width, subtitleSize.Height))
 		r.subtitleLabel.Show()
 	} else {
-		r.titleLabel.Move(fyne.NewPos(0, (size.Height-titleSize.Height)/2))
-		r.titleLabel.Resize(fyne.NewSize(size.Width, titleSize.Height))
+		r.layoutTitle(size.Width, (size.Height-titleSize.Height)/2)
 		r.subtitleLabel.Hide()
 	}
 }
@@ -217,6 +247,12 @@ func (r *navigationTitleRenderer) MinSize() fyne.Size {
 
 	width := titleSize.Width
 	height := titleSize.Height
+	if accessory := r.accessory(); accessory != nil {
+		r.titleView.mu.RLock()
+		width += r.titleView.AccessorySpacing
+		r.titleView.mu.RUnlock()
+		width += r.accessorySize(accessory).Width
+	}
 
 	if hasSubtitle {
 		subtitleSize := r.subtitleLabel.MinSize()
@@ -234,8 +270,16 @@ func (r *navigationTitleRenderer) Refresh() {
 	title := r.titleView.Title
 	subtitle := r.titleView.Subtitle
 	loading := r.titleView.loading
+	expanded := r.titleView.Expanded
 	r.titleView.mu.RUnlock()
 
+	if expanded {
+		r.disclosure.Resource = theme.MenuDropUpIcon()
+	} else {
+		r.disclosure.Resource = theme.MenuDropDownIcon()
+	}
+	r.disclosure.Refresh()
+
 	r.titleLabel.Text = title
 	r.titleLabel.Color = r.titleView.TitleColor
 	r.titleLabel.TextStyle = r.titleView.TitleTextStyle
@@ -256,10 +300,73 @@ func (r *navigationTitleRenderer) Refresh() {
 
 	r.titleLabel.Refresh()
 	r.subtitleLabel.Refresh()
+	r.Layout(r.titleView.Size())
 }
 
 func (r *navigationTitleRenderer) Objects() []fyne.CanvasObject {
-	return []fyne.CanvasObject{r.titleLabel, r.subtitleLabel, r.loading}
+	objects := []fyne.CanvasObject{r.titleLabel, r.subtitleLabel, r.loading}
+	if accessory := r.accessory(); accessory != nil {
+		objects = append(objects, accessory)
+	}
+	return objects
+}
+
+// accessory returns the object drawn after the title, or nil for none
+func (r *navigationTitleRenderer) accessory() fyne.CanvasObject {
+	r.titleView.mu.RLock()
+	defer r.titleView.mu.RUnlock()
+
+	if r.titleView.AccessoryView != nil {
+		return r.titleView.AccessoryView
+	}
+	if r.titleView.AccessoryType == AccessoryTypeDisclosureIndicator {
+		return r.disclosure
+	}
+	return nil
+}
+
+// accessorySize returns the size of the accessory, sized to the title text
+// for the disclosure indicator
+func (r *navigationTitleRenderer) accessorySize(accessory fyne.CanvasObject) fyne.Size {
+	if accessory == r.disclosure {
+		return fyne.NewSquareSize(r.titleLabel.MinSize().Height * 0.8)
+	}
+	return accessory.MinSize()
+}
+
+// layoutTitle places the title and the accessory after it as one group,
+// aligned by HorizontalAlignment
+func (r *navigationTitleRenderer) layoutTitle(width, y float32) {
+	titleSize := r.titleLabel.MinSize()
+	accessory := r.accessory()
+	if accessory == nil {
+		r.titleLabel.Move(fyne.NewPos(0, y))
+		r.titleLabel.Resize(fyne.NewSize(width, titleSize.Height))
+		return
+	}
+
+	r.titleView.mu.RLock()
+	spacing := r.titleView.AccessorySpacing
+	align := r.titleView.HorizontalAlignment
+	r.titleView.mu.RUnlock()
+
+	accessorySize := r.accessorySize(accessory)
+	groupWidth := titleSize.Width + spacing + accessorySize.Width
+	x := float32(0)
+	switch align {
+	case fyne.TextAlignCenter:
+		x = (width - groupWidth) / 2
+	case fyne.TextAlignTrailing:
+		x = width - groupWidth
+	}
+
+	r.titleLabel.Move(fyne.NewPos(x, y))
+	r.titleLabel.Resize(titleSize)
+	accessory.Resize(accessorySize)
+	accessory.Move(fyne.NewPos(
+		x+titleSize.Width+spacing,
+		y+(titleSize.Height-accessorySize.Height)/2,
+	))
 }
 
 // NavigationBar is a navigation bar component
@@ -431,6 +538,12 @@ type TabBar struct {
 	Items         []*TabBarItem
 	SelectedIndex int
 
+	// MaxVisibleItems caps the number of tabs shown. When there are more
+	// items, the last slot becomes MoreItem, which lists the rest in a menu.
+	// 0 shows every item.
+	MaxVisibleItems int
+	MoreItem        *TabBarItem
+
 	// Styling
 	BackgroundColor         color.Color
 	TintColor               color.Color
@@ -445,7 +558,19 @@ type TabBar struct {
 	// Callbacks
 	OnItemSelected func(index int)
 
-	mu sync.RWMutex
+	// Reordering: long-press a tab to pick it up, then drag it to a new slot.
+	// OnTabsReordered receives, for each slot, the index its item had before
+	// the move. The selection and badges stay with their items.
+	Reorderable       bool
+	LongPressDuration time.Duration
+	OnTabsReordered   func(order []int)
+
+	mu         sync.RWMutex
+	press      core.LongPress
+	dragItem   *TabBarItem
+	dragFrom   int
+	dragTo     int
+	dragX      float32
 }
 
 // TabBarItem represents an item in the tab bar
@@ -470,6 +595,8 @@ func NewTabBar(items []*TabBarItem) *TabBar {
 	tb := &TabBar{
 		Items:                   items,
 		SelectedIndex:           0,
+		MaxVisibleItems:         5,
+		MoreItem:                NewTabBarItem("More", theme.MoreHorizontalIcon()),
 		BackgroundColor:         config.TabBarBackgroundColor,
 		TintColor:               config.BlueColor,
 		UnselectedItemColor:     config.TabBarItemTitleColor,
@@ -479,6 +606,7 @@ func NewTabBar(items []*TabBarItem) *TabBar {
 		Height:                  49,
 		ItemTitleFontSize:       config.TabBarItemTitleFontSize,
 		ItemTitleFontSizeSelected: config.TabBarItemTitleFontSizeSelected,
+		LongPressDuration:       time.Millisecond * 500,
 	}
 	tb.ExtendBaseWidget(tb)
 	return tb
@@ -498,6 +626,191 @@ func (tb *TabBar) SetSelectedIndex(index int) {
 	}
 }
 
+// visibleCount returns how many items get a tab of their own, and whether
+// the rest overflow into the More tab
+func (tb *TabBar) visibleCount() (int, bool) {
+	if tb.MaxVisibleItems <= 0 || len(tb.Items) <= tb.MaxVisibleItems {
+		return len(tb.Items), false
+	}
+	return tb.MaxVisibleItems - 1, true
+}
+
+// orderLocked returns the items that get a tab of their own in slot order,
+// with a picked up item moved to the slot it is over. The caller must hold
+// tb.mu.
+func (tb *TabBar) orderLocked(visible int) []*TabBarItem {
+	items := tb.Items[:visible]
+	if tb.dragItem == nil {
+		return items
+	}
+	order := make([]*TabBarItem, 0, visible)
+	for _, item := range items {
+		if item != tb.dragItem {
+			order = append(order, item)
+		}
+	}
+	return append(order[:tb.dragTo], append([]*TabBarItem{tb.dragItem}, order[tb.dragTo:]...)...)
+}
+
+// slotWidth returns the width of each tab
+func (tb *TabBar) slotWidth() float32 {
+	visible, overflow := tb.visibleCount()
+	slots := visible
+	if overflow {
+		slots++
+	}
+	if slots == 0 {
+		return 0
+	}
+	return tb.Size().Width / float32(slots)
+}
+
+// IsReordering returns whether a tab is picked up
+func (tb *TabBar) IsReordering() bool {
+	tb.mu.RLock()
+	defer tb.mu.RUnlock()
+	return tb.dragItem != nil
+}
+
+// pressStarted starts the long press that picks up item
+func (tb *TabBar) pressStarted(item *TabBarItem) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	if !tb.Reorderable || tb.dragItem != nil {
+		return
+	}
+	tb.press.Start(tb.LongPressDuration, func() {
+		tb.pickUp(item)
+	})
+}
+
+// pressEnded drops a picked up tab, or cancels the long press
+func (tb *TabBar) pressEnded() {
+	tb.press.Cancel()
+	tb.mu.RLock()
+	picked := tb.dragItem != nil
+	tb.mu.RUnlock()
+
+	if picked {
+		tb.drop()
+	}
+}
+
+// pickUp lifts item above the other tabs once its long press completes
+func (tb *TabBar) pickUp(item *TabBarItem) {
+	visible, _ := tb.visibleCount()
+	slotWidth := tb.slotWidth()
+
+	tb.mu.Lock()
+	from := -1
+	for i, it := range tb.Items[:visible] {
+		if it == item {
+			from = i
+		}
+	}
+	if from < 0 {
+		tb.mu.Unlock()
+		return
+	}
+	tb.dragItem = item
+	tb.dragFrom = from
+	tb.dragTo = from
+	tb.dragX = float32(from) * slotWidth
+	tb.mu.Unlock()
+
+	core.Feedback(core.FeedbackSelectionChanged)
+	tb.Refresh()
+}
+
+// dragged moves the picked up tab, making room for it at the slot its
+// center is over
+func (tb *TabBar) dragged(ev *fyne.DragEvent) {
+	visible, _ := tb.visibleCount()
+	slotWidth := tb.slotWidth()
+
+	tb.mu.Lock()
+	if tb.dragItem == nil {
+		// Moving before the long press completes cancels it
+		tb.mu.Unlock()
+		tb.press.Moved(ev.Dragged)
+		return
+	}
+	tb.dragX += ev.Dragged.DX
+	to := 0
+	if slotWidth > 0 {
+		to = int((tb.dragX + slotWidth/2) / slotWidth)
+	}
+	if to < 0 {
+		to = 0
+	} else if to >= visible {
+		to = visible - 1
+	}
+	tb.dragTo = to
+	tb.mu.Unlock()
+
+	tb.Refresh()
+}
+
+// drop places the picked up tab in its new slot, keeping the selection on
+// the selected item, and reports the new order through OnTabsReordered
+func (tb *TabBar) drop() {
+	visible, _ := tb.visibleCount()
+
+	tb.press.Cancel()
+	tb.mu.Lock()
+	if tb.dragItem == nil {
+		tb.mu.Unlock()
+		return
+	}
+	var selected *TabBarItem
+	if tb.SelectedIndex >= 0 && tb.SelectedIndex < len(tb.Items) {
+		selected = tb.Items[tb.SelectedIndex]
+	}
+	previous := make(map[*TabBarItem]int, len(tb.Items))
+	for i, item := range tb.Items {
+		previous[item] = i
+	}
+
+	items := append(append([]*TabBarItem(nil), tb.orderLocked(visible)...), tb.Items[visible:]...)
+	order := make([]int, len(items))
+	for i, item := range items {
+		order[i] = previous[item]
+		if item == selected {
+			tb.SelectedIndex = i
+		}
+	}
+	tb.Items = items
+	moved := tb.dragFrom != tb.dragTo
+	tb.dragItem = nil
+	tb.mu.Unlock()
+
+	tb.Refresh()
+	if moved && tb.OnTabsReordered != nil {
+		tb.OnTabsReordered(order)
+	}
+}
+
+// showMoreMenu lists the overflow items next to the More tab
+func (tb *TabBar) showMoreMenu(moreTab fyne.CanvasObject) {
+	visible, _ := tb.visibleCount()
+
+	tb.mu.RLock()
+	selected := tb.SelectedIndex
+	tb.mu.RUnlock()
+
+	menu := popup.NewPopupMenu()
+	for i := visible; i < len(tb.Items); i++ {
+		index := i
+		item := popup.NewMenuItemWithIcon(tb.Items[i].Title, tb.Items[i].Icon, func(*popup.MenuItem) {
+			tb.SetSelectedIndex(index)
+		})
+		item.Checked = index == selected
+		menu.AddItem(item)
+	}
+	menu.ShowFromObject(moreTab)
+}
+
 // CreateRenderer implements fyne.Widget
 func (tb *TabBar) CreateRenderer() fyne.WidgetRenderer {
 	tb.ExtendBaseWidget(tb)
@@ -510,6 +823,7 @@ func (tb *TabBar) CreateRenderer() fyne.WidgetRenderer {
 		background: background,
 		shadow:     shadow,
 		items:      make([]*tabBarItemWidget, 0),
+		tabs:       make(map[*TabBarItem]*tabBarItemWidget),
 	}
 }
 
@@ -518,28 +832,58 @@ type tabBarRenderer struct {
 	background *canvas.Rectangle
 	shadow     *canvas.Rectangle
 	items      []*tabBarItemWidget
+	more       *tabBarItemWidget
+
+	// tabs keeps each item's tab, so a tab moves with its item when the
+	// items are reordered
+	tabs map[*TabBarItem]*tabBarItemWidget
 }
 
-func (r *tabBarRenderer) Destroy() {}
+func (r *tabBarRenderer) Destroy() {
+	toast.UnregisterBottomBar(r.tabBar)
+}
 
+// updateItems lines the tabs up in slot order, creating tabs for new items
 func (r *tabBarRenderer) updateItems() {
-	if len(r.items) != len(r.tabBar.Items) {
-		r.items = make([]*tabBarItemWidget, len(r.tabBar.Items))
-		for i, item := range r.tabBar.Items {
-			w := &tabBarItemWidget{
-				tabBar: r.tabBar,
-				index:  i,
-				item:   item,
-			}
+	visible, overflow := r.tabBar.visibleCount()
+
+	r.tabBar.mu.RLock()
+	order := r.tabBar.orderLocked(visible)
+	r.tabBar.mu.RUnlock()
+
+	items := make([]*tabBarItemWidget, 0, visible+1)
+	shown := make(map[*TabBarItem]*tabBarItemWidget, len(order))
+	for i, item := range order {
+		w := r.tabs[item]
+		if w == nil {
+			w = &tabBarItemWidget{tabBar: r.tabBar, item: item}
 			w.ExtendBaseWidget(w)
-			r.items[i] = w
 		}
+		w.index = i
+		shown[item] = w
+		items = append(items, w)
+	}
+	r.tabs = shown
+
+	if overflow {
+		if r.more == nil || r.more.item != r.tabBar.MoreItem {
+			r.more = &tabBarItemWidget{tabBar: r.tabBar, item: r.tabBar.MoreItem, more: true}
+			r.more.ExtendBaseWidget(r.more)
+		}
+		r.more.index = visible
+		items = append(items, r.more)
 	}
+	r.items = items
 }
 
 func (r *tabBarRenderer) Layout(size fyne.Size) {
 	r.updateItems()
 
+	// Keep bottom toasts above the tab bar
+	if c := fyne.CurrentApp().Driver().CanvasForObject(r.tabBar); c != nil {
+		toast.RegisterBottomBar(c, r.tabBar)
+	}
+
 	r.background.Resize(size)
 
 	// Shadow at top
@@ -550,15 +894,25 @@ func (r *tabBarRenderer) Layout(size fyne.Size) {
 		return
 	}
 
+	r.tabBar.mu.RLock()
+	dragItem := r.tabBar.dragItem
+	dragX := r.tabBar.dragX
+	r.tabBar.mu.RUnlock()
+
+	// Layout tabs, leaving a picked up tab where it was dragged to
 	itemWidth := size.Width / float32(len(r.items))
 	for i, item := range r.items {
 		item.Resize(fyne.NewSize(itemWidth, size.Height))
-		item.Move(fyne.NewPos(float32(i)*itemWidth, 0))
+		if !item.more && item.item == dragItem {
+			item.Move(fyne.NewPos(dragX, 0))
+		} else {
+			item.Move(fyne.NewPos(float32(i)*itemWidth, 0))
+		}
 	}
 }
 
 func (r *tabBarRenderer) MinSize() fyne.Size {
-	return fyne.NewSize(200, r.tabBar.Height)
+	return core.TouchTargetSize(fyne.NewSize(200, r.tabBar.Height))
 }
 
 func (r *tabBarRenderer) Refresh() {
@@ -575,6 +929,7 @@ func (r *tabBarRenderer) Refresh() {
 	r.background.Refresh()
 	r.shadow.Refresh()
 
+	r.Layout(r.tabBar.Size())
 	for _, item := range r.items {
 		item.Refresh()
 	}
@@ -582,10 +937,25 @@ func (r *tabBarRenderer) Refresh() {
 
 func (r *tabBarRenderer) Objects() []fyne.CanvasObject {
 	r.updateItems()
+
+	r.tabBar.mu.RLock()
+	dragItem := r.tabBar.dragItem
+	r.tabBar.mu.RUnlock()
+
 	objects := []fyne.CanvasObject{r.background, r.shadow}
+	var dragged fyne.CanvasObject
 	for _, item := range r.items {
+		if !item.more && item.item == dragItem {
+			dragged = item
+			continue
+		}
 		objects = append(objects, item)
 	}
+
+	// A picked up tab floats above the others
+	if dragged != nil {
+		objects = append(objects, dragged)
+	}
 	return objects
 }
 
@@ -595,6 +965,7 @@ type tabBarItemWidget struct {
 	tabBar *TabBar
 	index  int
 	item   *TabBarItem
+	more   bool // The More tab, standing in for items from index on
 }
 
 func (w *tabBarItemWidget) CreateRenderer() fyne.WidgetRenderer {
@@ -619,11 +990,54 @@ func (w *tabBarItemWidget) CreateRenderer() fyne.WidgetRenderer {
 }
 
 func (w *tabBarItemWidget) Tapped(_ *fyne.PointEvent) {
+	if w.more {
+		w.tabBar.showMoreMenu(w)
+		return
+	}
 	w.tabBar.SetSelectedIndex(w.index)
 }
 
 func (w *tabBarItemWidget) TappedSecondary(_ *fyne.PointEvent) {}
 
+// MouseDown implements desktop.Mouseable
+func (w *tabBarItemWidget) MouseDown(*desktop.MouseEvent) {
+	if !w.more {
+		w.tabBar.pressStarted(w.item)
+	}
+}
+
+// MouseUp implements desktop.Mouseable
+func (w *tabBarItemWidget) MouseUp(*desktop.MouseEvent) {
+	w.tabBar.pressEnded()
+}
+
+// TouchDown implements mobile.Touchable
+func (w *tabBarItemWidget) TouchDown(*mobile.TouchEvent) {
+	if !w.more {
+		w.tabBar.pressStarted(w.item)
+	}
+}
+
+// TouchUp implements mobile.Touchable
+func (w *tabBarItemWidget) TouchUp(*mobile.TouchEvent) {
+	w.tabBar.pressEnded()
+}
+
+// TouchCancel implements mobile.Touchable
+func (w *tabBarItemWidget) TouchCancel(*mobile.TouchEvent) {
+	w.tabBar.pressEnded()
+}
+
+// Dragged implements fyne.Draggable
+func (w *tabBarItemWidget) Dragged(ev *fyne.DragEvent) {
+	w.tabBar.dragged(ev)
+}
+
+// DragEnd implements fyne.Draggable
+func (w *tabBarItemWidget) DragEnd() {
+	w.tabBar.drop()
+}
+
 func (w *tabBarItemWidget) Cursor() desktop.Cursor {
 	return desktop.PointerCursor
 }
@@ -656,13 +1070,18 @@ func (r *tabBarItemRenderer) Layout(size fyne.Size) {
 }
 
 func (r *tabBarItemRenderer) MinSize() fyne.Size {
-	return fyne.NewSize(60, r.widget.tabBar.Height)
+	return core.TouchTargetSize(fyne.NewSize(60, r.widget.tabBar.Height))
 }
 
 func (r *tabBarItemRenderer) Refresh() {
-	r.widget.tabBar.mu.RLock()
-	selected := r.widget.index == r.widget.tabBar.SelectedIndex
-	r.widget.tabBar.mu.RUnlock()
+	// The selection follows the selected item while its tab is dragged
+	tb := r.widget.tabBar
+	tb.mu.RLock()
+	selected := tb.SelectedIndex >= 0 && tb.SelectedIndex < len(tb.Items) && tb.Items[tb.SelectedIndex] == r.widget.item
+	if r.widget.more {
+		selected = tb.SelectedIndex >= r.widget.index
+	}
+	tb.mu.RUnlock()
 
 	if selected {
 		r.title.Color = r.widget.tabBar.SelectedItemColor
@@ -4,11 +4,13 @@ package popup
 import (
 	"image/color"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
@@ -36,7 +38,9 @@ type MenuItem struct {
 	Title    string
 	Subtitle string
 	Icon     fyne.Resource
+	Shortcut string // Accelerator label such as "⌘C", shown right-aligned
 	Enabled  bool
+	Checked  bool // Draws a trailing checkmark for toggle items
 	Handler  func(item *MenuItem)
 
 	// Styling
@@ -84,12 +88,17 @@ type PopupContainer struct {
 	ContentView fyne.CanvasObject
 
 	// State
-	mu      sync.RWMutex
-	popup   *widget.PopUp
-	window  fyne.Window
-	visible bool
+	mu          sync.RWMutex
+	popup       *widget.PopUp
+	window      fyne.Window
+	visible     bool
+	arrowOffset float32
+	arrowTip    fyne.Position
 }
 
+// popupScreenMargin is the minimum distance kept between a popup and the window edges
+const popupScreenMargin = 8
+
 // NewPopupContainer creates a new popup container
 func NewPopupContainer() *PopupContainer {
 	config := core.SharedConfiguration()
@@ -116,13 +125,24 @@ func NewPopupContainer() *PopupContainer {
 func (pcv *PopupContainer) ShowAt(window fyne.Window, position fyne.Position) {
 	pcv.mu.Lock()
 	pcv.window = window
+	pcv.mu.Unlock()
+
+	pcv.showAtCanvas(window.Canvas(), position)
+}
+
+func (pcv *PopupContainer) showAtCanvas(c fyne.Canvas, position fyne.Position) {
+	pcv.mu.Lock()
 	pcv.visible = true
 	pcv.mu.Unlock()
 
 	content := pcv.buildContent()
-	pcv.popup = widget.NewPopUp(content, window.Canvas())
+	pcv.popup = widget.NewPopUp(content, c)
 	pcv.popup.Move(position)
-	pcv.popup.Show()
+	core.SharedOverlayManager().Show(c, pcv.popup, 0)
+	core.SharedOverlayManager().SetDismissHandler(pcv.popup, func() bool {
+		pcv.Hide()
+		return true
+	})
 }
 
 // ShowBelowView shows the popup below a view
@@ -143,13 +163,109 @@ func (pcv *PopupContainer) ShowAboveView(window fyne.Window, view fyne.CanvasObj
 	pcv.ShowAt(window, position)
 }
 
+// ShowRelativeTo shows the popup next to target with the arrow pointing at the
+// target's center, choosing the side with enough space in the window
+func (pcv *PopupContainer) ShowRelativeTo(window fyne.Window, target fyne.CanvasObject) {
+	pcv.mu.Lock()
+	pcv.window = window
+	pcv.mu.Unlock()
+
+	pcv.showRelativeTo(window.Canvas(), target)
+}
+
+func (pcv *PopupContainer) showRelativeTo(c fyne.Canvas, target fyne.CanvasObject) {
+	canvasSize := c.Size()
+	targetPos := fyne.CurrentApp().Driver().AbsolutePositionForObject(target)
+	targetSize := target.Size()
+	targetCenter := targetPos.Add(fyne.NewPos(targetSize.Width/2, targetSize.Height/2))
+
+	bodySize := pcv.buildBody().MinSize()
+	arrow := pcv.ArrowSize.Height
+
+	spaceBelow := canvasSize.Height - targetPos.Y - targetSize.Height
+	spaceAbove := targetPos.Y
+	spaceRight := canvasSize.Width - targetPos.X - targetSize.Width
+	spaceLeft := targetPos.X
+	neededHeight := bodySize.Height + arrow + popupScreenMargin
+	neededWidth := bodySize.Width + arrow + popupScreenMargin
+
+	var direction ArrowDirection
+	switch {
+	case spaceBelow >= neededHeight:
+		direction = ArrowDirectionUp
+	case spaceAbove >= neededHeight:
+		direction = ArrowDirectionDown
+	case spaceRight >= neededWidth:
+		direction = ArrowDirectionLeft
+	case spaceLeft >= neededWidth:
+		direction = ArrowDirectionRight
+	case spaceBelow >= spaceAbove:
+		direction = ArrowDirectionUp
+	default:
+		direction = ArrowDirectionDown
+	}
+
+	// Position the body, keeping it on screen, then aim the arrow at the target
+	var contentPos, tip fyne.Position
+	var offset float32
+	switch direction {
+	case ArrowDirectionUp, ArrowDirectionDown:
+		x := clampFloat(targetCenter.X-bodySize.Width/2, popupScreenMargin, canvasSize.Width-bodySize.Width-popupScreenMargin)
+		offset = targetCenter.X - x
+		if direction == ArrowDirectionUp {
+			tip = fyne.NewPos(targetCenter.X, targetPos.Y+targetSize.Height)
+			contentPos = fyne.NewPos(x, tip.Y)
+		} else {
+			tip = fyne.NewPos(targetCenter.X, targetPos.Y)
+			contentPos = fyne.NewPos(x, tip.Y-bodySize.Height-arrow)
+		}
+	default:
+		y := clampFloat(targetCenter.Y-bodySize.Height/2, popupScreenMargin, canvasSize.Height-bodySize.Height-popupScreenMargin)
+		offset = targetCenter.Y - y
+		if direction == ArrowDirectionLeft {
+			tip = fyne.NewPos(targetPos.X+targetSize.Width, targetCenter.Y)
+			contentPos = fyne.NewPos(tip.X, y)
+		} else {
+			tip = fyne.NewPos(targetPos.X, targetCenter.Y)
+			contentPos = fyne.NewPos(tip.X-bodySize.Width-arrow, y)
+		}
+	}
+
+	pcv.mu.Lock()
+	pcv.ArrowDirection = direction
+	pcv.arrowOffset = offset
+	pcv.arrowTip = tip
+	pcv.mu.Unlock()
+
+	// widget.PopUp insets its content by half the inner padding
+	inset := theme.InnerPadding() / 2
+	pcv.showAtCanvas(c, contentPos.Subtract(fyne.NewPos(inset, inset)))
+}
+
+// ArrowTipPosition returns the canvas position the arrow points at after ShowRelativeTo
+func (pcv *PopupContainer) ArrowTipPosition() fyne.Position {
+	pcv.mu.RLock()
+	defer pcv.mu.RUnlock()
+	return pcv.arrowTip
+}
+
+func clampFloat(v, min, max float32) float32 {
+	if v > max {
+		v = max
+	}
+	if v < min {
+		v = min
+	}
+	return v
+}
+
 // Hide hides the popup
 func (pcv *PopupContainer) Hide() {
 	pcv.mu.Lock()
 	defer pcv.mu.Unlock()
 
 	if pcv.popup != nil {
-		pcv.popup.Hide()
+		core.SharedOverlayManager().Hide(pcv.popup.Canvas, pcv.popup)
 		pcv.popup = nil
 	}
 	pcv.visible = false
@@ -163,6 +279,25 @@ func (pcv *PopupContainer) IsVisible() bool {
 }
 
 func (pcv *PopupContainer) buildContent() fyne.CanvasObject {
+	body := pcv.buildBody()
+	if pcv.ArrowDirection == ArrowDirectionNone {
+		return body
+	}
+
+	pcv.mu.RLock()
+	offset := pcv.arrowOffset
+	pcv.mu.RUnlock()
+
+	arrow := newArrow(pcv.ArrowDirection, pcv.BackgroundColor)
+	return container.New(&arrowLayout{
+		direction: pcv.ArrowDirection,
+		arrowSize: pcv.ArrowSize,
+		offset:    offset,
+		radius:    pcv.CornerRadius,
+	}, body, arrow)
+}
+
+func (pcv *PopupContainer) buildBody() fyne.CanvasObject {
 	background := canvas.NewRectangle(pcv.BackgroundColor)
 	background.CornerRadius = pcv.CornerRadius
 	background.StrokeWidth = pcv.BorderWidth
@@ -176,6 +311,96 @@ func (pcv *PopupContainer) buildContent() fyne.CanvasObject {
 	return content
 }
 
+// newArrow creates a triangle pointing in direction
+func newArrow(direction ArrowDirection, fill color.Color) *canvas.Raster {
+	return canvas.NewRasterWithPixels(func(x, y, w, h int) color.Color {
+		fx := (float32(x) + 0.5) / float32(w)
+		fy := (float32(y) + 0.5) / float32(h)
+
+		var along, across float32
+		switch direction {
+		case ArrowDirectionUp:
+			along, across = fy, fx
+		case ArrowDirectionDown:
+			along, across = 1-fy, fx
+		case ArrowDirectionLeft:
+			along, across = fx, fy
+		default:
+			along, across = 1-fx, fy
+		}
+
+		// The triangle widens from the tip (along = 0) to the base (along = 1)
+		if across-0.5 <= along/2 && 0.5-across <= along/2 {
+			return fill
+		}
+		return color.Transparent
+	})
+}
+
+// arrowLayout places a popup body and its arrow, with the arrow tip at offset
+// along the body edge (centered when offset is zero)
+type arrowLayout struct {
+	direction ArrowDirection
+	arrowSize fyne.Size
+	offset    float32
+	radius    float32
+}
+
+func (l *arrowLayout) vertical() bool {
+	return l.direction == ArrowDirectionUp || l.direction == ArrowDirectionDown
+}
+
+func (l *arrowLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	body := objects[0].MinSize()
+	if l.vertical() {
+		return fyne.NewSize(fyne.Max(body.Width, l.arrowSize.Width), body.Height+l.arrowSize.Height)
+	}
+	return fyne.NewSize(body.Width+l.arrowSize.Height, fyne.Max(body.Height, l.arrowSize.Width))
+}
+
+func (l *arrowLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	body, arrow := objects[0], objects[1]
+	depth := l.arrowSize.Height
+	base := l.arrowSize.Width
+
+	if l.vertical() {
+		bodySize := fyne.NewSize(size.Width, size.Height-depth)
+		tip := l.tipOffset(size.Width, base)
+		arrow.Resize(fyne.NewSize(base, depth))
+		if l.direction == ArrowDirectionUp {
+			arrow.Move(fyne.NewPos(tip-base/2, 0))
+			body.Move(fyne.NewPos(0, depth))
+		} else {
+			arrow.Move(fyne.NewPos(tip-base/2, bodySize.Height))
+			body.Move(fyne.NewPos(0, 0))
+		}
+		body.Resize(bodySize)
+		return
+	}
+
+	bodySize := fyne.NewSize(size.Width-depth, size.Height)
+	tip := l.tipOffset(size.Height, base)
+	arrow.Resize(fyne.NewSize(depth, base))
+	if l.direction == ArrowDirectionLeft {
+		arrow.Move(fyne.NewPos(0, tip-base/2))
+		body.Move(fyne.NewPos(depth, 0))
+	} else {
+		arrow.Move(fyne.NewPos(bodySize.Width, tip-base/2))
+		body.Move(fyne.NewPos(0, 0))
+	}
+	body.Resize(bodySize)
+}
+
+// tipOffset returns the arrow tip position along an edge of the given length,
+// kept clear of the rounded corners
+func (l *arrowLayout) tipOffset(length, base float32) float32 {
+	tip := l.offset
+	if tip <= 0 {
+		tip = length / 2
+	}
+	return clampFloat(tip, l.radius+base/2, length-l.radius-base/2)
+}
+
 func (pcv *PopupContainer) CreateRenderer() fyne.WidgetRenderer {
 	pcv.ExtendBaseWidget(pcv)
 	return &popupContainerRenderer{container: pcv}
@@ -207,9 +432,11 @@ type PopupMenu struct {
 	SubtitleFontSize       float32
 	TitleColor             color.Color
 	SubtitleColor          color.Color
+	ShortcutColor          color.Color
 	HighlightedColor       color.Color
 	IconSize               fyne.Size
 	SpacingBetweenIconAndTitle float32
+	SpacingBeforeShortcut      float32
 
 	// Behavior
 	ShouldDismissAfterSelection bool
@@ -233,9 +460,11 @@ func NewPopupMenu() *PopupMenu {
 		SubtitleFontSize:            theme.TextSize() - 2,
 		TitleColor:                  color.Black,
 		SubtitleColor:               config.GrayColor,
+		ShortcutColor:               config.GrayColor,
 		HighlightedColor:            color.RGBA{R: 0, G: 0, B: 0, A: 20},
 		IconSize:                    fyne.NewSize(20, 20),
 		SpacingBetweenIconAndTitle:  12,
+		SpacingBeforeShortcut:       24,
 		ShouldDismissAfterSelection: true,
 	}
 	return pmv
@@ -292,12 +521,34 @@ func (pmv *PopupMenu) createItemView(index int, item *MenuItem) fyne.CanvasObjec
 	return itemWidget
 }
 
+// hasIcons returns whether any item has an icon, in which case all titles
+// are indented to start at the same x
+func (pmv *PopupMenu) hasIcons() bool {
+	for _, item := range pmv.Items {
+		if item.Icon != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Show displays the popup menu
 func (pmv *PopupMenu) Show(window fyne.Window, position fyne.Position) {
 	pmv.buildMenuContent()
 	pmv.PopupContainer.ShowAt(window, position)
 }
 
+// ShowFromObject shows the menu next to target on the canvas that holds it,
+// for callers that do not have the window at hand
+func (pmv *PopupMenu) ShowFromObject(target fyne.CanvasObject) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(target)
+	if c == nil {
+		return
+	}
+	pmv.buildMenuContent()
+	pmv.PopupContainer.showRelativeTo(c, target)
+}
+
 // menuItemWidget represents a menu item in the popup
 type menuItemWidget struct {
 	widget.BaseWidget
@@ -339,13 +590,27 @@ func (w *menuItemWidget) CreateRenderer() fyne.WidgetRenderer {
 		subtitle.TextSize = w.menu.SubtitleFontSize
 	}
 
-	return &menuItemRenderer{
+	var shortcut *canvas.Text
+	if w.item.Shortcut != "" {
+		shortcut = canvas.NewText(w.item.Shortcut, w.menu.ShortcutColor)
+		shortcut.TextSize = w.menu.SubtitleFontSize
+		shortcut.Alignment = fyne.TextAlignTrailing
+	}
+
+	checkmark := canvas.NewImageFromResource(theme.NewPrimaryThemedResource(theme.ConfirmIcon()))
+	checkmark.FillMode = canvas.ImageFillContain
+
+	r := &menuItemRenderer{
 		widget:     w,
 		background: background,
 		icon:       icon,
 		title:      title,
 		subtitle:   subtitle,
+		shortcut:   shortcut,
+		checkmark:  checkmark,
 	}
+	r.Refresh()
+	return r
 }
 
 func (w *menuItemWidget) Tapped(_ *fyne.PointEvent) {
@@ -400,8 +665,13 @@ type menuItemRenderer struct {
 	icon       *canvas.Image
 	title      *canvas.Text
 	subtitle   *canvas.Text
+	shortcut   *canvas.Text
+	checkmark  *canvas.Image
 }
 
+// checkmarkSize is the size of the trailing checkmark on checked items
+const checkmarkSize = 16
+
 func (r *menuItemRenderer) Destroy() {}
 
 func (r *menuItemRenderer) Layout(size fyne.Size) {
@@ -411,10 +681,12 @@ func (r *menuItemRenderer) Layout(size fyne.Size) {
 	x := padding
 	centerY := size.Height / 2
 
+	iconSize := r.widget.menu.IconSize
 	if r.icon != nil {
-		iconSize := r.widget.menu.IconSize
 		r.icon.Resize(iconSize)
 		r.icon.Move(fyne.NewPos(x, centerY-iconSize.Height/2))
+	}
+	if r.icon != nil || r.widget.menu.hasIcons() {
 		x += iconSize.Width + r.widget.menu.SpacingBetweenIconAndTitle
 	}
 
@@ -428,12 +700,26 @@ func (r *menuItemRenderer) Layout(size fyne.Size) {
 	} else {
 		r.title.Move(fyne.NewPos(x, centerY-titleSize.Height/2))
 	}
+
+	trailing := size.Width - padding
+	if r.widget.item.Checked {
+		trailing -= checkmarkSize
+		r.checkmark.Resize(fyne.NewSquareSize(checkmarkSize))
+		r.checkmark.Move(fyne.NewPos(trailing, centerY-checkmarkSize/2))
+		trailing -= r.widget.menu.SpacingBetweenIconAndTitle
+	}
+
+	if r.shortcut != nil {
+		shortcutSize := r.shortcut.MinSize()
+		r.shortcut.Resize(shortcutSize)
+		r.shortcut.Move(fyne.NewPos(trailing-shortcutSize.Width, centerY-shortcutSize.Height/2))
+	}
 }
 
 func (r *menuItemRenderer) MinSize() fyne.Size {
 	width := r.widget.menu.ItemPaddingHorizontal * 2
 
-	if r.icon != nil {
+	if r.icon != nil || r.widget.menu.hasIcons() {
 		width += r.widget.menu.IconSize.Width + r.widget.menu.SpacingBetweenIconAndTitle
 	}
 
@@ -447,6 +733,13 @@ func (r *menuItemRenderer) MinSize() fyne.Size {
 		}
 	}
 
+	if r.shortcut != nil {
+		width += r.widget.menu.SpacingBeforeShortcut + r.shortcut.MinSize().Width
+	}
+	if r.widget.item.Checked {
+		width += r.widget.menu.SpacingBetweenIconAndTitle + checkmarkSize
+	}
+
 	return fyne.NewSize(width, r.widget.menu.ItemHeight)
 }
 
@@ -461,8 +754,29 @@ func (r *menuItemRenderer) Refresh() {
 		r.background.FillColor = color.Transparent
 	}
 
+	titleColor := r.widget.menu.TitleColor
+	if r.widget.item.TitleColor != nil {
+		titleColor = r.widget.item.TitleColor
+	}
 	if !r.widget.item.Enabled {
-		r.title.Color = core.ColorWithAlpha(r.title.Color, 0.5)
+		titleColor = core.ColorWithAlpha(titleColor, 0.5)
+	}
+	r.title.Color = titleColor
+
+	if r.widget.item.Checked {
+		r.checkmark.Show()
+	} else {
+		r.checkmark.Hide()
+	}
+	r.checkmark.Refresh()
+
+	if r.shortcut != nil {
+		r.shortcut.Text = r.widget.item.Shortcut
+		r.shortcut.Color = r.widget.menu.ShortcutColor
+		if !r.widget.item.Enabled {
+			r.shortcut.Color = core.ColorWithAlpha(r.shortcut.Color, 0.5)
+		}
+		r.shortcut.Refresh()
 	}
 
 	r.background.Refresh()
@@ -484,9 +798,202 @@ func (r *menuItemRenderer) Objects() []fyne.CanvasObject {
 	if r.subtitle != nil {
 		objects = append(objects, r.subtitle)
 	}
+	if r.shortcut != nil {
+		objects = append(objects, r.shortcut)
+	}
+	objects = append(objects, r.checkmark)
 	return objects
 }
 
+// Tooltip shows a small text bubble after the pointer hovers a target
+type Tooltip struct {
+	widget.BaseWidget
+
+	// Content
+	Text   string
+	Target fyne.CanvasObject
+
+	// Behavior
+	Delay time.Duration
+
+	// Container is the bubble, exposed for styling
+	Container *PopupContainer
+
+	mu    sync.Mutex
+	timer *time.Timer
+	hover *tooltipHoverArea
+}
+
+// NewTooltip creates a tooltip for target
+func NewTooltip(target fyne.CanvasObject, text string) *Tooltip {
+	config := core.SharedConfiguration()
+	bubble := NewPopupContainer()
+	bubble.ArrowSize = fyne.NewSize(12, 6)
+	bubble.CornerRadius = 4
+	bubble.ContentEdgeInsets = core.NewEdgeInsets(4, 8, 4, 8)
+	bubble.BorderColor = config.SeparatorColor
+
+	tt := &Tooltip{
+		Text:      text,
+		Target:    target,
+		Delay:     time.Millisecond * 500,
+		Container: bubble,
+	}
+	tt.hover = &tooltipHoverArea{tooltip: tt}
+	tt.hover.ExtendBaseWidget(tt.hover)
+	tt.ExtendBaseWidget(tt)
+	return tt
+}
+
+// AttachTooltip wraps target so that hovering it shows text in a tooltip.
+// Use the returned Tooltip in place of target in the layout.
+func AttachTooltip(target fyne.CanvasObject, text string) *Tooltip {
+	return NewTooltip(target, text)
+}
+
+// SetText sets the tooltip text
+func (tt *Tooltip) SetText(text string) {
+	tt.mu.Lock()
+	tt.Text = text
+	tt.mu.Unlock()
+}
+
+// IsShowing returns whether the tooltip bubble is visible
+func (tt *Tooltip) IsShowing() bool {
+	return tt.Container.IsVisible()
+}
+
+// ShowAfterDelay shows the tooltip below or beside Target once Delay has
+// elapsed. Widgets that track hovering themselves can use it without placing
+// the Tooltip in their layout.
+func (tt *Tooltip) ShowAfterDelay() {
+	tt.scheduleShow()
+}
+
+// Dismiss cancels a pending tooltip and hides the bubble
+func (tt *Tooltip) Dismiss() {
+	tt.hide()
+}
+
+// scheduleShow shows the tooltip once the pointer has rested for Delay
+func (tt *Tooltip) scheduleShow() {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	if tt.timer != nil || tt.Container.IsVisible() {
+		return
+	}
+	tt.timer = time.AfterFunc(tt.Delay, func() {
+		fyne.Do(tt.show)
+	})
+}
+
+func (tt *Tooltip) show() {
+	tt.mu.Lock()
+	if tt.timer == nil {
+		tt.mu.Unlock()
+		return
+	}
+	tt.timer = nil
+	text := tt.Text
+	tt.mu.Unlock()
+
+	c := fyne.CurrentApp().Driver().CanvasForObject(tt.Target)
+	if c == nil || text == "" {
+		return
+	}
+
+	label := canvas.NewText(text, theme.ForegroundColor())
+	label.TextSize = theme.CaptionTextSize()
+	insets := tt.Container.ContentEdgeInsets
+	tt.Container.ContentView = container.New(layout.NewCustomPaddedLayout(insets.Top, insets.Bottom, insets.Left, insets.Right), label)
+	tt.Container.showRelativeTo(c, tt.Target)
+}
+
+// hide cancels a pending tooltip and hides the bubble
+func (tt *Tooltip) hide() {
+	tt.mu.Lock()
+	if tt.timer != nil {
+		tt.timer.Stop()
+		tt.timer = nil
+	}
+	tt.mu.Unlock()
+
+	tt.Container.Hide()
+}
+
+// CreateRenderer implements fyne.Widget
+func (tt *Tooltip) CreateRenderer() fyne.WidgetRenderer {
+	tt.ExtendBaseWidget(tt)
+	return &tooltipRenderer{tooltip: tt}
+}
+
+type tooltipRenderer struct {
+	tooltip *Tooltip
+}
+
+func (r *tooltipRenderer) Destroy() {
+	r.tooltip.hide()
+}
+
+func (r *tooltipRenderer) Layout(size fyne.Size) {
+	r.tooltip.Target.Resize(size)
+	r.tooltip.Target.Move(fyne.NewPos(0, 0))
+	r.tooltip.hover.Resize(size)
+	r.tooltip.hover.Move(fyne.NewPos(0, 0))
+}
+
+func (r *tooltipRenderer) MinSize() fyne.Size {
+	return r.tooltip.Target.MinSize()
+}
+
+func (r *tooltipRenderer) Refresh() {
+	r.tooltip.Target.Refresh()
+}
+
+func (r *tooltipRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.tooltip.Target, r.tooltip.hover}
+}
+
+// tooltipHoverArea sits above the target to track hovering, forwarding pointer
+// events to the target so it keeps its own hover effects. It is not tappable,
+// so taps still reach the target.
+type tooltipHoverArea struct {
+	widget.BaseWidget
+	tooltip *Tooltip
+}
+
+func (h *tooltipHoverArea) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+func (h *tooltipHoverArea) MouseIn(e *desktop.MouseEvent) {
+	if hoverable, ok := h.tooltip.Target.(desktop.Hoverable); ok {
+		hoverable.MouseIn(e)
+	}
+	h.tooltip.scheduleShow()
+}
+
+func (h *tooltipHoverArea) MouseMoved(e *desktop.MouseEvent) {
+	if hoverable, ok := h.tooltip.Target.(desktop.Hoverable); ok {
+		hoverable.MouseMoved(e)
+	}
+}
+
+func (h *tooltipHoverArea) MouseOut() {
+	if hoverable, ok := h.tooltip.Target.(desktop.Hoverable); ok {
+		hoverable.MouseOut()
+	}
+	h.tooltip.hide()
+}
+
+func (h *tooltipHoverArea) Cursor() desktop.Cursor {
+	if cursorable, ok := h.tooltip.Target.(desktop.Cursorable); ok {
+		return cursorable.Cursor()
+	}
+	return desktop.DefaultCursor
+}
+
 // ContextMenu shows a context menu at the given position
 func ContextMenu(window fyne.Window, position fyne.Position, items []*MenuItem) *PopupMenu {
 	menu := NewPopupMenuWithItems(items)
@@ -0,0 +1,163 @@
+package progress
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// countdownTick is how often a running Countdown updates its ring
+const countdownTick = time.Millisecond * 50
+
+// Countdown counts down a duration on a RingProgress, depleting the ring
+// from full to empty and showing the remaining time
+type Countdown struct {
+	*RingProgress
+
+	// Duration is the time counted down from
+	Duration time.Duration
+
+	// OnComplete is called when the countdown reaches zero
+	OnComplete func()
+
+	timerMu   sync.Mutex
+	remaining time.Duration
+	deadline  time.Time
+	stop      chan struct{}
+}
+
+// NewCountdown creates a countdown of duration, full and paused
+func NewCountdown(duration time.Duration) *Countdown {
+	ring := NewRingProgress()
+	ring.ShowsText = true
+	ring.Progress = 1
+
+	cd := &Countdown{RingProgress: ring, Duration: duration, remaining: duration}
+	ring.TextFormatter = cd.formatRemaining
+	return cd
+}
+
+// Start starts or resumes the countdown
+func (cd *Countdown) Start() {
+	cd.timerMu.Lock()
+	defer cd.timerMu.Unlock()
+
+	if cd.stop != nil || cd.remaining <= 0 {
+		return
+	}
+	cd.deadline = time.Now().Add(cd.remaining)
+	cd.stop = make(chan struct{})
+	go cd.run(cd.stop)
+}
+
+// Pause stops the countdown, keeping the remaining time
+func (cd *Countdown) Pause() {
+	cd.timerMu.Lock()
+	defer cd.timerMu.Unlock()
+
+	if cd.stop == nil {
+		return
+	}
+	close(cd.stop)
+	cd.stop = nil
+	cd.remaining = time.Until(cd.deadline)
+	if cd.remaining < 0 {
+		cd.remaining = 0
+	}
+}
+
+// Reset stops the countdown and fills the ring to count down duration
+func (cd *Countdown) Reset(duration time.Duration) {
+	cd.timerMu.Lock()
+	if cd.stop != nil {
+		close(cd.stop)
+		cd.stop = nil
+	}
+	cd.Duration = duration
+	cd.remaining = duration
+	cd.timerMu.Unlock()
+
+	cd.SetProgress(1)
+}
+
+// Remaining returns the time left to count down
+func (cd *Countdown) Remaining() time.Duration {
+	cd.timerMu.Lock()
+	defer cd.timerMu.Unlock()
+
+	if cd.stop == nil {
+		return cd.remaining
+	}
+	if left := time.Until(cd.deadline); left > 0 {
+		return left
+	}
+	return 0
+}
+
+// IsRunning returns whether the countdown is counting down
+func (cd *Countdown) IsRunning() bool {
+	cd.timerMu.Lock()
+	defer cd.timerMu.Unlock()
+	return cd.stop != nil
+}
+
+func (cd *Countdown) run(stop chan struct{}) {
+	ticker := time.NewTicker(countdownTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if cd.tick(stop) {
+				return
+			}
+		}
+	}
+}
+
+// tick updates the ring, returning true once the countdown has completed
+func (cd *Countdown) tick(stop chan struct{}) bool {
+	cd.timerMu.Lock()
+	if cd.stop != stop {
+		cd.timerMu.Unlock()
+		return true
+	}
+	left := time.Until(cd.deadline)
+	done := left <= 0
+	if done {
+		left = 0
+		cd.remaining = 0
+		cd.stop = nil
+	}
+	duration := cd.Duration
+	cd.timerMu.Unlock()
+
+	progress := 0.0
+	if duration > 0 {
+		progress = float64(left) / float64(duration)
+	}
+	cd.SetProgress(progress)
+
+	if done && cd.OnComplete != nil {
+		fyne.Do(cd.OnComplete)
+	}
+	return done
+}
+
+// formatRemaining shows whole seconds left, rounded up, as "30s" or "1:30"
+func (cd *Countdown) formatRemaining(progress float64) string {
+	cd.timerMu.Lock()
+	duration := cd.Duration
+	cd.timerMu.Unlock()
+
+	seconds := int(math.Ceil(progress * duration.Seconds()))
+	if seconds >= 60 {
+		return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+	}
+	return fmt.Sprintf("%ds", seconds)
+}
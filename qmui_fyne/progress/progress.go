@@ -6,9 +6,11 @@ import (
 	"image/color"
 	"math"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
@@ -32,6 +34,7 @@ type PieProgress struct {
 	// Shape
 	ShowsTrack bool
 	Clockwise  bool
+	StartAngle float32 // Degrees clockwise from the top where the fill begins
 
 	mu sync.RWMutex
 }
@@ -96,6 +99,8 @@ func (r *pieProgressRenderer) buildObjects(size fyne.Size) {
 	r.view.mu.RLock()
 	progress := r.view.Progress
 	showTrack := r.view.ShowsTrack
+	clockwise := r.view.Clockwise
+	start := r.view.StartAngle
 	r.view.mu.RUnlock()
 
 	centerX := size.Width / 2
@@ -114,12 +119,25 @@ func (r *pieProgressRenderer) buildObjects(size fyne.Size) {
 
 	// Progress pie wedge (filled from center)
 	if progress > 0 {
-		wedge := r.createPieWedge(centerX, centerY, radius, progress)
+		startAngle, endAngle := arcAngles(start, clockwise, 0, progress)
+		wedge := r.createPieWedge(centerX, centerY, radius, startAngle, endAngle, progress)
 		r.objects = append(r.objects, wedge...)
 	}
 }
 
-func (r *pieProgressRenderer) createPieWedge(cx, cy, radius float32, progress float64) []fyne.CanvasObject {
+// arcAngles returns the start and end of an arc covering progress of a
+// circle, in radians as used by math.Cos and math.Sin on the canvas. The arc
+// begins startDegrees clockwise from the top, turned by rotation radians.
+func arcAngles(startDegrees float32, clockwise bool, rotation, progress float64) (float64, float64) {
+	sweep := 2 * math.Pi * progress
+	if !clockwise {
+		sweep, rotation = -sweep, -rotation
+	}
+	start := -math.Pi/2 + float64(startDegrees)*math.Pi/180 + rotation
+	return start, start + sweep
+}
+
+func (r *pieProgressRenderer) createPieWedge(cx, cy, radius float32, startAngle, endAngle, progress float64) []fyne.CanvasObject {
 	var objects []fyne.CanvasObject
 
 	// Draw filled pie wedge using dense radial lines from center
@@ -129,9 +147,6 @@ func (r *pieProgressRenderer) createPieWedge(cx, cy, radius float32, progress fl
 		segments = 10
 	}
 
-	startAngle := -math.Pi / 2 // Start from top (12 o'clock)
-	endAngle := startAngle + 2*math.Pi*progress
-
 	// Draw many radial lines to fill the pie wedge solidly
 	for i := 0; i <= segments; i++ {
 		t := float64(i) / float64(segments)
@@ -223,9 +238,35 @@ type RingProgress struct {
 	LabelColor    color.Color
 	LabelFontSize float32
 
-	mu sync.RWMutex
+	// TextFormatter returns the label text for a progress of 0.0 - 1.0,
+	// overriding LabelFormat, e.g. for "3/10" or "4.2s"
+	TextFormatter func(progress float64) string
+
+	// Indeterminate spins a partial arc instead of showing Progress
+	Indeterminate bool
+
+	// Clockwise and StartAngle set the direction of the arc and where it
+	// begins, in degrees clockwise from the top
+	Clockwise  bool
+	StartAngle float32
+
+	// Interactive lets a tap or drag set the progress to the angle around
+	// the ring, calling OnSeek with the new value
+	Interactive bool
+	OnSeek      func(value float64)
+
+	mu        sync.RWMutex
+	spinAngle float64
+	stopSpin  chan struct{}
+	seeking   bool
 }
 
+// indeterminateArcLength is the fraction of the ring drawn while spinning
+const indeterminateArcLength = 0.25
+
+// indeterminateRevolution is how long one spin of the indeterminate arc takes
+const indeterminateRevolution = time.Second
+
 // NewRingProgress creates a new circular progress view
 func NewRingProgress() *RingProgress {
 	config := core.SharedConfiguration()
@@ -240,6 +281,7 @@ func NewRingProgress() *RingProgress {
 		LabelFormat:   "%.0f%%",
 		LabelColor:    color.Black,
 		LabelFontSize: 12,
+		Clockwise:     true,
 	}
 	cpv.ExtendBaseWidget(cpv)
 	return cpv
@@ -255,18 +297,136 @@ func (cpv *RingProgress) SetProgress(progress float64) {
 	})
 }
 
+// SetIndeterminate starts or stops the spinning indeterminate arc
+func (cpv *RingProgress) SetIndeterminate(indeterminate bool) {
+	cpv.mu.Lock()
+	cpv.Indeterminate = indeterminate
+	if indeterminate && cpv.stopSpin == nil {
+		cpv.stopSpin = make(chan struct{})
+		go cpv.spin(cpv.stopSpin)
+	} else if !indeterminate && cpv.stopSpin != nil {
+		close(cpv.stopSpin)
+		cpv.stopSpin = nil
+		cpv.spinAngle = 0
+	}
+	cpv.mu.Unlock()
+	cpv.Refresh()
+}
+
+// IsIndeterminate returns whether the indeterminate arc is spinning
+func (cpv *RingProgress) IsIndeterminate() bool {
+	cpv.mu.RLock()
+	defer cpv.mu.RUnlock()
+	return cpv.Indeterminate
+}
+
+func (cpv *RingProgress) spin(stop chan struct{}) {
+	const tick = time.Millisecond * 16
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	step := 2 * math.Pi * float64(tick) / float64(indeterminateRevolution)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			cpv.mu.Lock()
+			cpv.spinAngle = math.Mod(cpv.spinAngle+step, 2*math.Pi)
+			cpv.mu.Unlock()
+			fyne.Do(cpv.Refresh)
+		}
+	}
+}
+
+// SetTextFormatter sets the function that formats the label text
+func (cpv *RingProgress) SetTextFormatter(formatter func(progress float64) string) {
+	cpv.mu.Lock()
+	cpv.TextFormatter = formatter
+	cpv.mu.Unlock()
+	cpv.Refresh()
+}
+
+// Text returns the label text for the current progress
+func (cpv *RingProgress) Text() string {
+	cpv.mu.RLock()
+	progress := cpv.Progress
+	formatter := cpv.TextFormatter
+	cpv.mu.RUnlock()
+
+	if formatter != nil {
+		return formatter(progress)
+	}
+	format := cpv.LabelFormat
+	if format == "" {
+		format = "%.0f%%"
+	}
+	return fmt.Sprintf(format, progress*100)
+}
+
+// seekTo sets the progress to the angle of pos around the ring's center.
+// While dragging, passing the start of the ring pins the value to the end
+// it came from rather than wrapping around.
+func (cpv *RingProgress) seekTo(pos fyne.Position, dragging bool) {
+	size := cpv.Size()
+	dx := float64(pos.X - size.Width/2)
+	dy := float64(pos.Y - size.Height/2)
+
+	cpv.mu.Lock()
+	if !cpv.Interactive || cpv.Indeterminate {
+		cpv.mu.Unlock()
+		return
+	}
+	angle := math.Atan2(dx, -dy) - float64(cpv.StartAngle)*math.Pi/180
+	if !cpv.Clockwise {
+		angle = -angle
+	}
+	value := math.Mod(angle/(2*math.Pi)+2, 1)
+	if dragging && cpv.seeking {
+		if cpv.Progress > 0.75 && value < 0.25 {
+			value = 1
+		} else if cpv.Progress < 0.25 && value > 0.75 {
+			value = 0
+		}
+	}
+	cpv.seeking = dragging
+	onSeek := cpv.OnSeek
+	cpv.mu.Unlock()
+
+	cpv.SetProgress(value)
+	if onSeek != nil {
+		onSeek(value)
+	}
+}
+
+// endSeek ends a seeking drag
+func (cpv *RingProgress) endSeek() {
+	cpv.mu.Lock()
+	cpv.seeking = false
+	cpv.mu.Unlock()
+}
+
 // CreateRenderer implements fyne.Widget
 func (cpv *RingProgress) CreateRenderer() fyne.WidgetRenderer {
 	cpv.ExtendBaseWidget(cpv)
-	return &circularProgressRenderer{view: cpv}
+	return &circularProgressRenderer{
+		view: cpv,
+		seek: newSeekLayer(cpv.seekTo, cpv.endSeek),
+	}
 }
 
 type circularProgressRenderer struct {
 	view    *RingProgress
 	objects []fyne.CanvasObject
+	label   *canvas.Text
+	seek    *seekLayer
 }
 
-func (r *circularProgressRenderer) Destroy() {}
+func (r *circularProgressRenderer) Destroy() {
+	if r.view.IsIndeterminate() {
+		r.view.SetIndeterminate(false)
+	}
+}
 
 func (r *circularProgressRenderer) buildObjects(size fyne.Size) {
 	r.objects = nil
@@ -286,33 +446,53 @@ func (r *circularProgressRenderer) buildObjects(size fyne.Size) {
 	r.view.mu.RLock()
 	progress := r.view.Progress
 	showLabel := r.view.ShowsText
+	indeterminate := r.view.Indeterminate
+	spinAngle := r.view.spinAngle
+	clockwise := r.view.Clockwise
+	start := r.view.StartAngle
 	r.view.mu.RUnlock()
 
 	// Progress arc
-	if progress > 0 {
-		arc := r.createArc(centerX, centerY, radius, progress)
+	if indeterminate {
+		startAngle, endAngle := arcAngles(start, clockwise, spinAngle, indeterminateArcLength)
+		arc := r.createArc(centerX, centerY, radius, startAngle, endAngle, indeterminateArcLength)
+		r.objects = append(r.objects, arc...)
+	} else if progress > 0 {
+		startAngle, endAngle := arcAngles(start, clockwise, 0, progress)
+		arc := r.createArc(centerX, centerY, radius, startAngle, endAngle, progress)
 		r.objects = append(r.objects, arc...)
 	}
 
 	// Label
-	if showLabel {
-		labelText := r.view.LabelFormat
-		if labelText == "" {
-			labelText = "%.0f%%"
-		}
-		label := canvas.NewText(
-			fmt.Sprintf(labelText, progress*100),
-			r.view.LabelColor,
-		)
-		label.TextSize = r.view.LabelFontSize
-		label.Alignment = fyne.TextAlignCenter
+	r.label = nil
+	if showLabel && !indeterminate {
+		label := r.newLabel()
 		labelSize := label.MinSize()
+		label.Resize(labelSize)
 		label.Move(fyne.NewPos(centerX-labelSize.Width/2, centerY-labelSize.Height/2))
 		r.objects = append(r.objects, label)
+		r.label = label
+	}
+
+	// Only an interactive ring takes taps and drags, so a display-only
+	// ring does not stop a drag from scrolling its container
+	r.view.mu.RLock()
+	interactive := r.view.Interactive
+	r.view.mu.RUnlock()
+	if interactive {
+		r.seek.Resize(size)
+		r.objects = append(r.objects, r.seek)
 	}
 }
 
-func (r *circularProgressRenderer) createArc(cx, cy, radius float32, progress float64) []fyne.CanvasObject {
+func (r *circularProgressRenderer) newLabel() *canvas.Text {
+	label := canvas.NewText(r.view.Text(), r.view.LabelColor)
+	label.TextSize = r.view.LabelFontSize
+	label.Alignment = fyne.TextAlignCenter
+	return label
+}
+
+func (r *circularProgressRenderer) createArc(cx, cy, radius float32, startAngle, endAngle, progress float64) []fyne.CanvasObject {
 	var objects []fyne.CanvasObject
 
 	segments := int(progress * 36)
@@ -320,9 +500,6 @@ func (r *circularProgressRenderer) createArc(cx, cy, radius float32, progress fl
 		segments = 1
 	}
 
-	startAngle := -math.Pi / 2
-	endAngle := startAngle + 2*math.Pi*progress
-
 	for i := 0; i < segments; i++ {
 		t1 := float64(i) / float64(segments)
 		t2 := float64(i+1) / float64(segments)
@@ -349,12 +526,27 @@ func (r *circularProgressRenderer) Layout(size fyne.Size) {
 	r.buildObjects(size)
 }
 
+// MinSize grows the ring when the label text does not fit inside it
 func (r *circularProgressRenderer) MinSize() fyne.Size {
-	return r.view.ViewSize
+	size := r.view.ViewSize
+	if !r.view.ShowsText || r.view.IsIndeterminate() {
+		return size
+	}
+
+	fit := r.newLabel().MinSize().Width + 2*r.view.LineWidth + 4
+	if fit > min(size.Width, size.Height) {
+		return fyne.NewSize(fyne.Max(size.Width, fit), fyne.Max(size.Height, fit))
+	}
+	return size
 }
 
 func (r *circularProgressRenderer) Refresh() {
-	r.buildObjects(r.view.ViewSize)
+	size := r.view.Size()
+	if size.IsZero() {
+		size = r.view.ViewSize
+	}
+	r.buildObjects(size)
+	canvas.Refresh(r.view)
 }
 
 func (r *circularProgressRenderer) Objects() []fyne.CanvasObject {
@@ -377,6 +569,11 @@ type ProgressBar struct {
 	Height          float32
 	CornerRadius    float32
 
+	// Interactive lets a tap or drag set the progress to the position along
+	// the bar, calling OnSeek with the new value
+	Interactive bool
+	OnSeek      func(value float64)
+
 	mu sync.RWMutex
 }
 
@@ -404,6 +601,25 @@ func (lpv *ProgressBar) SetProgress(progress float64) {
 	})
 }
 
+// seekTo sets the progress to the position of pos along the bar
+func (lpv *ProgressBar) seekTo(pos fyne.Position, _ bool) {
+	width := lpv.Size().Width
+
+	lpv.mu.RLock()
+	interactive := lpv.Interactive
+	onSeek := lpv.OnSeek
+	lpv.mu.RUnlock()
+	if !interactive || width <= 0 {
+		return
+	}
+
+	value := core.ClampFloat64(float64(pos.X/width), 0, 1)
+	lpv.SetProgress(value)
+	if onSeek != nil {
+		onSeek(value)
+	}
+}
+
 // CreateRenderer implements fyne.Widget
 func (lpv *ProgressBar) CreateRenderer() fyne.WidgetRenderer {
 	lpv.ExtendBaseWidget(lpv)
@@ -418,6 +634,7 @@ func (lpv *ProgressBar) CreateRenderer() fyne.WidgetRenderer {
 		view:     lpv,
 		track:    track,
 		progress: progress,
+		seek:     newSeekLayer(lpv.seekTo, nil),
 	}
 }
 
@@ -425,6 +642,7 @@ type linearProgressRenderer struct {
 	view     *ProgressBar
 	track    *canvas.Rectangle
 	progress *canvas.Rectangle
+	seek     *seekLayer
 	size     fyne.Size
 }
 
@@ -433,6 +651,7 @@ func (r *linearProgressRenderer) Destroy() {}
 func (r *linearProgressRenderer) Layout(size fyne.Size) {
 	r.size = size
 	r.track.Resize(size)
+	r.seek.Resize(size)
 
 	r.view.mu.RLock()
 	progress := r.view.Progress
@@ -466,9 +685,57 @@ func (r *linearProgressRenderer) Refresh() {
 }
 
 func (r *linearProgressRenderer) Objects() []fyne.CanvasObject {
+	r.view.mu.RLock()
+	interactive := r.view.Interactive
+	r.view.mu.RUnlock()
+
+	if interactive {
+		return []fyne.CanvasObject{r.track, r.progress, r.seek}
+	}
 	return []fyne.CanvasObject{r.track, r.progress}
 }
 
+// seekLayer covers an interactive progress view and turns taps and drags
+// into seeks to the pointer position
+type seekLayer struct {
+	widget.BaseWidget
+	seek    func(pos fyne.Position, dragging bool)
+	dragEnd func()
+}
+
+func newSeekLayer(seek func(pos fyne.Position, dragging bool), dragEnd func()) *seekLayer {
+	l := &seekLayer{seek: seek, dragEnd: dragEnd}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// Tapped implements fyne.Tappable
+func (l *seekLayer) Tapped(ev *fyne.PointEvent) {
+	l.seek(ev.Position, false)
+}
+
+// Dragged implements fyne.Draggable
+func (l *seekLayer) Dragged(ev *fyne.DragEvent) {
+	l.seek(ev.Position, true)
+}
+
+// DragEnd implements fyne.Draggable
+func (l *seekLayer) DragEnd() {
+	if l.dragEnd != nil {
+		l.dragEnd()
+	}
+}
+
+// Cursor implements desktop.Cursorable
+func (l *seekLayer) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+// CreateRenderer implements fyne.Widget
+func (l *seekLayer) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
 // ApplyTheme implements the Themeable interface for PieProgress
 func (ppv *PieProgress) ApplyTheme(t *theme.Theme) {
 	ppv.TintColor = t.PrimaryColor
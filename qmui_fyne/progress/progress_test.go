@@ -1,12 +1,26 @@
 package progress
 
 import (
+	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/test"
 )
 
+// waitFor polls cond until it holds or a second has passed, returning
+// whether it held
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for !cond() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return cond()
+}
+
 func TestPieProgress_VisualUpdate(t *testing.T) {
 	pie := NewPieProgress()
 
@@ -122,3 +136,243 @@ func TestProgressViews_MinSize(t *testing.T) {
 		})
 	}
 }
+
+func TestRingProgress_Indeterminate(t *testing.T) {
+	ring := NewRingProgress()
+
+	w := test.NewWindow(ring)
+	defer w.Close()
+	w.Resize(fyne.NewSize(100, 100))
+
+	ring.SetIndeterminate(true)
+	if !ring.IsIndeterminate() {
+		t.Fatal("Ring should be indeterminate")
+	}
+	if len(test.WidgetRenderer(ring).Objects()) < 2 {
+		t.Error("Indeterminate ring should draw an arc even at zero progress")
+	}
+
+	rotated := waitFor(func() bool {
+		ring.mu.RLock()
+		defer ring.mu.RUnlock()
+		return ring.spinAngle != 0
+	})
+	if !rotated {
+		t.Error("Indeterminate arc should rotate over time")
+	}
+
+	ring.SetIndeterminate(false)
+	if len(test.WidgetRenderer(ring).Objects()) != 1 {
+		t.Error("Stopping should clear the arc when progress is zero")
+	}
+}
+
+func TestRingProgress_DirectionAndStartAngle(t *testing.T) {
+	ring := NewRingProgress()
+	ring.Progress = 0.25
+	renderer := test.WidgetRenderer(ring)
+
+	// The ring is 50x50 with a 4px line, so the arc has radius 23 around (25, 25)
+	arcEnds := func() (fyne.Position, fyne.Position) {
+		renderer.Refresh()
+		objects := renderer.Objects()
+		first := objects[1].(*canvas.Line)
+		last := objects[len(objects)-1].(*canvas.Line)
+		return first.Position1, last.Position2
+	}
+	near := func(a, b fyne.Position) bool {
+		return math.Abs(float64(a.X-b.X)) < 0.5 && math.Abs(float64(a.Y-b.Y)) < 0.5
+	}
+
+	tests := []struct {
+		name       string
+		clockwise  bool
+		startAngle float32
+		start, end fyne.Position
+	}{
+		{"clockwise from top", true, 0, fyne.NewPos(25, 2), fyne.NewPos(48, 25)},
+		{"counter-clockwise from top", false, 0, fyne.NewPos(25, 2), fyne.NewPos(2, 25)},
+		{"clockwise from bottom", true, 180, fyne.NewPos(25, 48), fyne.NewPos(2, 25)},
+	}
+	for _, tt := range tests {
+		ring.Clockwise = tt.clockwise
+		ring.StartAngle = tt.startAngle
+		start, end := arcEnds()
+		if !near(start, tt.start) || !near(end, tt.end) {
+			t.Errorf("%s: arc should run from %v to %v, got %v to %v", tt.name, tt.start, tt.end, start, end)
+		}
+	}
+}
+
+func TestPieProgress_CounterClockwise(t *testing.T) {
+	pie := NewPieProgress()
+	pie.Progress = 0.25
+	pie.Clockwise = false
+	renderer := test.WidgetRenderer(pie)
+	renderer.Refresh()
+
+	// Radial lines run from the centre; the last one should point left
+	var last *canvas.Line
+	for _, o := range renderer.Objects() {
+		if line, ok := o.(*canvas.Line); ok && line.Position1 == fyne.NewPos(18.5, 18.5) {
+			last = line
+		}
+	}
+	if last == nil || last.Position2.X >= 18.5 {
+		t.Error("A counter-clockwise quarter should end on the left of the pie")
+	}
+}
+
+func TestRingProgress_TextFormatter(t *testing.T) {
+	ring := NewRingProgress()
+	ring.ShowsText = true
+
+	w := test.NewWindow(ring)
+	defer w.Close()
+
+	ring.SetProgress(0.42)
+	if ring.Text() != "42%" {
+		t.Errorf("Default text should be a whole percentage, got %q", ring.Text())
+	}
+
+	ring.SetTextFormatter(func(progress float64) string {
+		return fmt.Sprintf("%d/10", int(progress*10))
+	})
+	ring.SetProgress(0.3)
+	if ring.Text() != "3/10" {
+		t.Errorf("TextFormatter should format the text, got %q", ring.Text())
+	}
+
+	renderer := test.WidgetRenderer(ring).(*circularProgressRenderer)
+	ring.Resize(fyne.NewSize(80, 80))
+	renderer.Refresh()
+	label := renderer.label
+	if label == nil || label.Text != "3/10" {
+		t.Fatal("Label should show the formatted text")
+	}
+	center := label.Position().Add(fyne.NewPos(label.Size().Width/2, label.Size().Height/2))
+	if center != fyne.NewPos(40, 40) {
+		t.Errorf("Label should stay centered, centre at %v", center)
+	}
+
+	// Wider text grows the ring so it still fits
+	ring.SetTextFormatter(func(float64) string { return "downloading 3 of 10" })
+	if size := renderer.MinSize(); size.Width <= ring.ViewSize.Width || size.Width != size.Height {
+		t.Errorf("MinSize should grow to fit wider text, got %v", size)
+	}
+}
+
+func TestCountdown_StartPauseReset(t *testing.T) {
+	cd := NewCountdown(30 * time.Second)
+	w := test.NewWindow(cd)
+	defer w.Close()
+
+	if cd.Progress != 1 || cd.Text() != "30s" {
+		t.Errorf("New countdown should be full and show 30s, got %v %q", cd.Progress, cd.Text())
+	}
+
+	cd.Start()
+	waitFor(func() bool { return cd.Remaining() < 30*time.Second })
+	cd.Pause()
+	paused := cd.Remaining()
+	if cd.IsRunning() || paused >= 30*time.Second || paused < 29*time.Second {
+		t.Errorf("Pause should keep the remaining time, got %v", paused)
+	}
+	// A running countdown would have moved on by the next reading
+	if cd.Remaining() != paused {
+		t.Error("Paused countdown should not count down")
+	}
+
+	cd.Reset(90 * time.Second)
+	if cd.IsRunning() || cd.Remaining() != 90*time.Second || cd.Text() != "1:30" {
+		t.Errorf("Reset should refill the ring, got %v %q", cd.Remaining(), cd.Text())
+	}
+}
+
+func TestCountdown_OnComplete(t *testing.T) {
+	cd := NewCountdown(100 * time.Millisecond)
+	w := test.NewWindow(cd)
+	defer w.Close()
+
+	completed := make(chan struct{})
+	cd.OnComplete = func() { close(completed) }
+	cd.Start()
+
+	select {
+	case <-completed:
+	case <-time.After(time.Second):
+		t.Fatal("OnComplete should fire when the countdown reaches zero")
+	}
+	if cd.IsRunning() || cd.Remaining() != 0 || cd.Text() != "0s" {
+		t.Errorf("Completed countdown should be empty, got %v %q", cd.Remaining(), cd.Text())
+	}
+}
+
+func TestProgressViews_InteractiveSeek(t *testing.T) {
+	seekLayerOf := func(w fyne.Widget) *seekLayer {
+		for _, o := range test.WidgetRenderer(w).Objects() {
+			if l, ok := o.(*seekLayer); ok {
+				return l
+			}
+		}
+		return nil
+	}
+
+	bar := NewProgressBar()
+	w := test.NewWindow(bar)
+	defer w.Close()
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(200, 20))
+	if seekLayerOf(bar) != nil {
+		t.Fatal("A display-only bar should not take taps")
+	}
+
+	var seeks []float64
+	bar.Interactive = true
+	bar.OnSeek = func(value float64) { seeks = append(seeks, value) }
+	bar.Refresh()
+	layer := seekLayerOf(bar)
+	if layer == nil {
+		t.Fatal("An interactive bar should take taps")
+	}
+	test.TapAt(layer, fyne.NewPos(50, 10))
+	layer.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(250, 10)}})
+	if len(seeks) != 2 || seeks[0] != 0.25 || seeks[1] != 1 {
+		t.Errorf("Bar should seek to 0.25 then clamp to 1, got %v", seeks)
+	}
+	if bar.Progress != 1 {
+		t.Errorf("Seeking should set the progress, got %v", bar.Progress)
+	}
+
+	ring := NewRingProgress()
+	ring.Interactive = true
+	seeks = nil
+	ring.OnSeek = func(value float64) { seeks = append(seeks, value) }
+	w.SetContent(ring)
+	w.Resize(fyne.NewSize(100, 100))
+	ring.Refresh()
+	layer = seekLayerOf(ring)
+	if layer == nil {
+		t.Fatal("An interactive ring should take taps")
+	}
+
+	// Right of center is a quarter turn clockwise from the top
+	test.TapAt(layer, fyne.NewPos(90, 50))
+	if math.Abs(ring.Progress-0.25) > 0.001 {
+		t.Errorf("Tapping right of center should seek to 0.25, got %v", ring.Progress)
+	}
+
+	// Dragging from nearly full past the top stays full instead of wrapping
+	layer.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(45, 10)}})
+	layer.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(55, 10)}})
+	layer.DragEnd()
+	if ring.Progress != 1 || seeks[len(seeks)-1] != 1 {
+		t.Errorf("Dragging past the top should pin the ring at 1, got %v", ring.Progress)
+	}
+
+	ring.Clockwise = false
+	test.TapAt(layer, fyne.NewPos(90, 50))
+	if math.Abs(ring.Progress-0.75) > 0.001 {
+		t.Errorf("A counter-clockwise ring should map the right side to 0.75, got %v", ring.Progress)
+	}
+}
@@ -9,11 +9,22 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
 )
 
+// SwitchSize selects the dimensions of a switch
+type SwitchSize int
+
+const (
+	// SwitchSizeRegular is the standard 51x31 iOS switch
+	SwitchSizeRegular SwitchSize = iota
+	// SwitchSizeSmall is a compact switch for dense rows
+	SwitchSizeSmall
+)
+
 // Switch is a custom switch control that allows for different on/off tint colors
 type Switch struct {
 	widget.BaseWidget
@@ -23,31 +34,52 @@ type Switch struct {
 	Enabled bool
 
 	// Styling
-	OnTintColor  color.Color
-	OffTintColor color.Color
-	ThumbColor   color.Color
+	OnTintColor    color.Color
+	OffTintColor   color.Color
+	ThumbTintColor color.Color
+	SwitchSize     SwitchSize
 
 	// Callbacks
 	OnChanged func(bool)
 
+	// AccessibilityLabel is announced by screen readers before the state
+	AccessibilityLabel string
+
 	mu      sync.RWMutex
 	hovered bool
+	focused bool
 }
 
 // NewSwitch creates a new custom switch
 func NewSwitch(onChanged func(bool)) *Switch {
+	config := core.SharedConfiguration()
 	s := &Switch{
-		Checked:      false,
-		Enabled:      true,
-		OnTintColor:  core.SharedConfiguration().BlueColor,
-		OffTintColor: color.RGBA{R: 224, G: 224, B: 224, A: 255},
-		ThumbColor:   color.White,
-		OnChanged:    onChanged,
+		Checked:        false,
+		Enabled:        true,
+		OnTintColor:    config.BlueColor,
+		OffTintColor:   color.RGBA{R: 224, G: 224, B: 224, A: 255},
+		ThumbTintColor: color.White,
+		SwitchSize:     SwitchSizeRegular,
+		OnChanged:      onChanged,
+	}
+	if config.SwitchOffTintColor != nil {
+		s.OffTintColor = config.SwitchOffTintColor
+	}
+	if config.SwitchThumbTintColor != nil {
+		s.ThumbTintColor = config.SwitchThumbTintColor
 	}
 	s.ExtendBaseWidget(s)
 	return s
 }
 
+// SetSwitchSize sets the size variant of the switch
+func (s *Switch) SetSwitchSize(size SwitchSize) {
+	s.mu.Lock()
+	s.SwitchSize = size
+	s.mu.Unlock()
+	s.Refresh()
+}
+
 // SetChecked sets the checked state of the switch
 func (s *Switch) SetChecked(checked bool) {
 	if s.Checked == checked {
@@ -71,11 +103,83 @@ func (s *Switch) Tapped(*fyne.PointEvent) {
 		return
 	}
 	s.Toggle()
+	core.Feedback(toggleFeedback(s.Checked))
+}
+
+// toggleFeedback returns the feedback kind for a switch turned on or off
+func toggleFeedback(on bool) core.FeedbackKind {
+	if on {
+		return core.FeedbackToggleOn
+	}
+	return core.FeedbackToggleOff
 }
 
 // TappedSecondary is called when a secondary tap event is received
 func (s *Switch) TappedSecondary(*fyne.PointEvent) {}
 
+// Enable enables the switch, implementing fyne.Disableable
+func (s *Switch) Enable() {
+	s.mu.Lock()
+	s.Enabled = true
+	s.mu.Unlock()
+	s.Refresh()
+}
+
+// Disable disables the switch, implementing fyne.Disableable
+func (s *Switch) Disable() {
+	s.mu.Lock()
+	s.Enabled = false
+	s.mu.Unlock()
+	s.Refresh()
+}
+
+// Disabled returns whether the switch is disabled, so focus traversal skips it
+func (s *Switch) Disabled() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return !s.Enabled
+}
+
+// FocusGained implements fyne.Focusable
+func (s *Switch) FocusGained() {
+	s.mu.Lock()
+	s.focused = true
+	s.mu.Unlock()
+	s.Refresh()
+}
+
+// FocusLost implements fyne.Focusable
+func (s *Switch) FocusLost() {
+	s.mu.Lock()
+	s.focused = false
+	s.mu.Unlock()
+	s.Refresh()
+}
+
+// TypedRune implements fyne.Focusable
+func (s *Switch) TypedRune(rune) {}
+
+// TypedKey implements fyne.Focusable, toggling the switch on Space or Return
+func (s *Switch) TypedKey(key *fyne.KeyEvent) {
+	if core.IsActivationKey(key) {
+		s.Tapped(nil)
+	}
+}
+
+// AccessibilityDescription implements core.Accessible
+func (s *Switch) AccessibilityDescription() core.AccessibilityDescription {
+	value := "off"
+	if s.Checked {
+		value = "on"
+	}
+	return core.AccessibilityDescription{
+		Role:     core.AccessibilityRoleSwitch,
+		Label:    s.AccessibilityLabel,
+		Value:    value,
+		Disabled: !s.Enabled,
+	}
+}
+
 // MouseIn is called when a desktop pointer enters the widget
 func (s *Switch) MouseIn(*desktop.MouseEvent) {
 	s.hovered = true
@@ -104,24 +208,35 @@ func (s *Switch) CreateRenderer() fyne.WidgetRenderer {
 	s.ExtendBaseWidget(s)
 
 	track := canvas.NewRectangle(s.OffTintColor)
-	thumb := canvas.NewCircle(s.ThumbColor)
+	thumb := canvas.NewCircle(s.ThumbTintColor)
+
+	focusRing := core.NewFocusRing()
 
 	return &switchRenderer{
-		sw:      s,
-		track:   track,
-		thumb:   thumb,
-		objects: []fyne.CanvasObject{track, thumb},
+		sw:        s,
+		track:     track,
+		thumb:     thumb,
+		focusRing: focusRing,
+		// The ring sits outside the track, so it can draw first
+		objects: []fyne.CanvasObject{focusRing, track, thumb},
 	}
 }
 
 type switchRenderer struct {
-	sw      *Switch
-	track   *canvas.Rectangle
-	thumb   *canvas.Circle
-	objects []fyne.CanvasObject
+	sw        *Switch
+	track     *canvas.Rectangle
+	thumb     *canvas.Circle
+	focusRing *canvas.Rectangle
+	objects   []fyne.CanvasObject
 }
 
 func (r *switchRenderer) MinSize() fyne.Size {
+	r.sw.mu.RLock()
+	defer r.sw.mu.RUnlock()
+
+	if r.sw.SwitchSize == SwitchSizeSmall {
+		return fyne.NewSize(39, 24)
+	}
 	return fyne.NewSize(51, 31)
 }
 
@@ -139,6 +254,8 @@ func (r *switchRenderer) Layout(size fyne.Size) {
 		thumbPos = fyne.NewPos(1, 1)
 	}
 	r.thumb.Move(thumbPos)
+
+	core.UpdateFocusRing(r.focusRing, size, size.Height/2, r.sw.focused)
 }
 
 func (r *switchRenderer) Refresh() {
@@ -150,7 +267,7 @@ func (r *switchRenderer) Refresh() {
 	} else {
 		r.track.FillColor = r.sw.OffTintColor
 	}
-	r.thumb.FillColor = r.sw.ThumbColor
+	r.thumb.FillColor = r.sw.ThumbTintColor
 
 	if !r.sw.Enabled {
 		config := core.SharedConfiguration()
@@ -169,3 +286,126 @@ func (r *switchRenderer) Objects() []fyne.CanvasObject {
 }
 
 func (r *switchRenderer) Destroy() {}
+
+// LabeledSwitch is a tappable row with a label on the left and a switch on the right
+type LabeledSwitch struct {
+	widget.BaseWidget
+
+	// Content
+	Text string
+
+	// Styling
+	TextColor color.Color
+	TextSize  float32
+	Spacing   float32
+
+	// Switch is the underlying switch, exposed for color and size configuration
+	Switch *Switch
+
+	mu sync.RWMutex
+}
+
+// NewSwitchWithLabel creates a switch with a leading text label
+func NewSwitchWithLabel(text string, onChanged func(bool)) *LabeledSwitch {
+	ls := &LabeledSwitch{
+		Text:      text,
+		TextColor: theme.ForegroundColor(),
+		TextSize:  theme.TextSize(),
+		Spacing:   12,
+		Switch:    NewSwitch(onChanged),
+	}
+	ls.ExtendBaseWidget(ls)
+	return ls
+}
+
+// SetText sets the label text
+func (ls *LabeledSwitch) SetText(text string) {
+	ls.mu.Lock()
+	ls.Text = text
+	ls.mu.Unlock()
+	ls.Refresh()
+}
+
+// Tapped toggles the switch when anywhere on the row is tapped
+func (ls *LabeledSwitch) Tapped(e *fyne.PointEvent) {
+	ls.Switch.Tapped(e)
+}
+
+// TappedSecondary is called when a secondary tap event is received
+func (ls *LabeledSwitch) TappedSecondary(*fyne.PointEvent) {}
+
+// AccessibilityDescription implements core.Accessible, labelling the switch
+// with Text unless the switch has its own AccessibilityLabel
+func (ls *LabeledSwitch) AccessibilityDescription() core.AccessibilityDescription {
+	desc := ls.Switch.AccessibilityDescription()
+	if desc.Label == "" {
+		ls.mu.RLock()
+		desc.Label = ls.Text
+		ls.mu.RUnlock()
+	}
+	return desc
+}
+
+// Cursor returns the cursor type of this widget
+func (ls *LabeledSwitch) Cursor() desktop.Cursor {
+	return ls.Switch.Cursor()
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer
+func (ls *LabeledSwitch) CreateRenderer() fyne.WidgetRenderer {
+	ls.ExtendBaseWidget(ls)
+
+	label := canvas.NewText(ls.Text, ls.TextColor)
+	label.TextSize = ls.TextSize
+
+	return &labeledSwitchRenderer{
+		row:   ls,
+		label: label,
+	}
+}
+
+type labeledSwitchRenderer struct {
+	row   *LabeledSwitch
+	label *canvas.Text
+}
+
+func (r *labeledSwitchRenderer) MinSize() fyne.Size {
+	labelSize := r.label.MinSize()
+	switchSize := r.row.Switch.MinSize()
+	return fyne.NewSize(
+		labelSize.Width+r.row.Spacing+switchSize.Width,
+		fyne.Max(labelSize.Height, switchSize.Height),
+	)
+}
+
+func (r *labeledSwitchRenderer) Layout(size fyne.Size) {
+	labelSize := r.label.MinSize()
+	r.label.Resize(labelSize)
+	r.label.Move(fyne.NewPos(0, (size.Height-labelSize.Height)/2))
+
+	switchSize := r.row.Switch.MinSize()
+	r.row.Switch.Resize(switchSize)
+	r.row.Switch.Move(fyne.NewPos(size.Width-switchSize.Width, (size.Height-switchSize.Height)/2))
+}
+
+func (r *labeledSwitchRenderer) Refresh() {
+	r.row.mu.RLock()
+	r.label.Text = r.row.Text
+	r.label.Color = r.row.TextColor
+	r.label.TextSize = r.row.TextSize
+	r.row.mu.RUnlock()
+
+	if !r.row.Switch.Enabled {
+		r.label.Color = core.ColorWithAlpha(r.label.Color, core.SharedConfiguration().ControlDisabledAlpha)
+	}
+
+	r.label.Refresh()
+	r.row.Switch.Refresh()
+	r.Layout(r.row.Size())
+}
+
+func (r *labeledSwitchRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.label, r.row.Switch}
+}
+
+func (r *labeledSwitchRenderer) Destroy() {}
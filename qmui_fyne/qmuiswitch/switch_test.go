@@ -2,8 +2,11 @@
 package qmuiswitch_test
 
 import (
+	"image/color"
 	"testing"
 
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/paul-hammant/qmui_fyne/qmuiswitch"
@@ -43,3 +46,60 @@ func TestSwitch_Tapped(t *testing.T) {
 	test.Tap(s)
 	assert.True(t, s.Checked)
 }
+
+func TestSwitch_NewSwitchWithLabel(t *testing.T) {
+	test.NewApp()
+	var changed bool
+	row := qmuiswitch.NewSwitchWithLabel("Wi-Fi", func(b bool) {
+		changed = b
+	})
+	assert.Equal(t, "Wi-Fi", row.Text)
+	assert.NotNil(t, row.Switch)
+
+	w := test.NewWindow(row)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 60))
+
+	// Tapping the row outside the switch toggles it
+	test.TapAt(row, fyne.NewPos(5, 5))
+	assert.True(t, row.Switch.Checked)
+	assert.True(t, changed)
+
+	row.Switch.Enabled = false
+	test.Tap(row)
+	assert.True(t, row.Switch.Checked)
+
+	assert.Greater(t, row.MinSize().Width, row.Switch.MinSize().Width)
+}
+
+func TestSwitch_ColorsAndSize(t *testing.T) {
+	test.NewApp()
+	s := qmuiswitch.NewSwitch(nil)
+	regular := s.MinSize()
+
+	s.SetSwitchSize(qmuiswitch.SwitchSizeSmall)
+	small := s.MinSize()
+	assert.Less(t, small.Width, regular.Width)
+	assert.Less(t, small.Height, regular.Height)
+
+	accent := color.RGBA{R: 255, G: 100, A: 255}
+	s.OnTintColor = accent
+	s.ThumbTintColor = color.Black
+	s.SetChecked(true)
+
+	w := test.NewWindow(s)
+	defer w.Close()
+	var track *canvas.Rectangle
+	var thumb *canvas.Circle
+	for _, obj := range test.WidgetRenderer(s).Objects() {
+		switch o := obj.(type) {
+		case *canvas.Rectangle:
+			track = o
+		case *canvas.Circle:
+			thumb = o
+		}
+	}
+	s.Refresh()
+	assert.Equal(t, accent, track.FillColor)
+	assert.Equal(t, color.Black, thumb.FillColor)
+}
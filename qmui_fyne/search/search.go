@@ -3,6 +3,7 @@
 package search
 
 import (
+	"fmt"
 	"image/color"
 	"sync"
 
@@ -46,10 +47,17 @@ type SearchBar struct {
 	ContentInsets            core.EdgeInsets
 	TextFieldInsets          core.EdgeInsets
 
-	// Icons
+	// Icons. ClearIcon is shown inside the field while it has text, and
+	// defaults to the theme's cancel icon.
 	SearchIcon fyne.Resource
 	ClearIcon  fyne.Resource
 
+	// ResultsCountProvider, when set, returns the number of results for
+	// the current text, shown as a trailing hint such as "(12 results)"
+	// while the field has text
+	ResultsCountProvider func() int
+	ResultsCountColor    color.Color
+
 	// Buttons
 	ShowsCancelButton  bool
 	CancelButtonTitle  string
@@ -88,6 +96,7 @@ func NewSearchBar() *SearchBar {
 		ShowsCancelButton:        false,
 		CancelButtonTitle:        "Cancel",
 		CancelButtonColor:        config.BlueColor,
+		ResultsCountColor:        config.SearchBarPlaceholderColor,
 	}
 	sb.ExtendBaseWidget(sb)
 	return sb
@@ -118,6 +127,11 @@ func (sb *SearchBar) GetText() string {
 	return sb.Text
 }
 
+// Clear empties the search text, as tapping the clear button does
+func (sb *SearchBar) Clear() {
+	sb.SetText("")
+}
+
 // SetShowsCancelButton sets whether the cancel button is visible
 func (sb *SearchBar) SetShowsCancelButton(show bool) {
 	sb.mu.Lock()
@@ -170,6 +184,7 @@ func (sb *SearchBar) CreateRenderer() fyne.WidgetRenderer {
 		if sb.Delegate != nil {
 			sb.Delegate.SearchBarTextDidChange(sb, text)
 		}
+		sb.Refresh()
 	}
 	entry.OnSubmitted = func(text string) {
 		if sb.OnSearchClicked != nil {
@@ -191,23 +206,43 @@ func (sb *SearchBar) CreateRenderer() fyne.WidgetRenderer {
 		}
 	})
 
-	return &searchBarRenderer{
-		searchBar:   sb,
-		background:  background,
-		textFieldBg: textFieldBg,
-		searchIcon:  searchIcon,
-		entry:       entry,
-		cancelBtn:   cancelBtn,
+	// Clear button, shown inside the field while it has text
+	clearBtn := widget.NewButtonWithIcon("", sb.clearIcon(), sb.Clear)
+	clearBtn.Importance = widget.LowImportance
+
+	resultsCount := canvas.NewText("", sb.ResultsCountColor)
+	resultsCount.TextSize = sb.FontSize - 2
+
+	r := &searchBarRenderer{
+		searchBar:    sb,
+		background:   background,
+		textFieldBg:  textFieldBg,
+		searchIcon:   searchIcon,
+		entry:        entry,
+		clearBtn:     clearBtn,
+		resultsCount: resultsCount,
+		cancelBtn:    cancelBtn,
+	}
+	r.Refresh()
+	return r
+}
+
+func (sb *SearchBar) clearIcon() fyne.Resource {
+	if sb.ClearIcon != nil {
+		return sb.ClearIcon
 	}
+	return theme.CancelIcon()
 }
 
 type searchBarRenderer struct {
-	searchBar   *SearchBar
-	background  *canvas.Rectangle
-	textFieldBg *canvas.Rectangle
-	searchIcon  *canvas.Circle
-	entry       *widget.Entry
-	cancelBtn   *widget.Button
+	searchBar    *SearchBar
+	background   *canvas.Rectangle
+	textFieldBg  *canvas.Rectangle
+	searchIcon   *canvas.Circle
+	entry        *widget.Entry
+	clearBtn     *widget.Button
+	resultsCount *canvas.Text
+	cancelBtn    *widget.Button
 }
 
 func (r *searchBarRenderer) Destroy() {}
@@ -242,10 +277,29 @@ func (r *searchBarRenderer) Layout(size fyne.Size) {
 	r.searchIcon.Resize(fyne.NewSize(iconSize, iconSize))
 	r.searchIcon.Move(fyne.NewPos(insets.Left+12, insets.Top+(textFieldHeight-iconSize)/2))
 
+	// Clear button and results count sit at the trailing end of the field
+	textFieldRight := insets.Left + textFieldWidth
+	var trailingWidth float32
+	if r.clearBtn.Visible() {
+		clearSize := r.clearBtn.MinSize()
+		if clearSize.Height > textFieldHeight {
+			clearSize.Height = textFieldHeight
+		}
+		trailingWidth += clearSize.Width
+		r.clearBtn.Resize(clearSize)
+		r.clearBtn.Move(fyne.NewPos(textFieldRight-trailingWidth, insets.Top+(textFieldHeight-clearSize.Height)/2))
+	}
+	if r.resultsCount.Visible() {
+		countSize := r.resultsCount.MinSize()
+		trailingWidth += countSize.Width + 4
+		r.resultsCount.Resize(countSize)
+		r.resultsCount.Move(fyne.NewPos(textFieldRight-trailingWidth, insets.Top+(textFieldHeight-countSize.Height)/2))
+	}
+
 	// Entry - use full text field height, only apply horizontal insets
 	entryInsets := r.searchBar.TextFieldInsets
 	r.entry.Resize(fyne.NewSize(
-		textFieldWidth-entryInsets.Left-entryInsets.Right,
+		textFieldWidth-entryInsets.Left-entryInsets.Right-trailingWidth,
 		textFieldHeight,
 	))
 	r.entry.Move(fyne.NewPos(insets.Left+entryInsets.Left, insets.Top))
@@ -270,18 +324,37 @@ func (r *searchBarRenderer) Refresh() {
 
 	r.searchBar.mu.RLock()
 	text := r.searchBar.Text
+	countProvider := r.searchBar.ResultsCountProvider
 	r.searchBar.mu.RUnlock()
 
 	if r.entry.Text != text {
 		r.entry.SetText(text)
 	}
 
+	r.clearBtn.SetIcon(r.searchBar.clearIcon())
+	if text != "" {
+		r.clearBtn.Show()
+	} else {
+		r.clearBtn.Hide()
+	}
+
+	if countProvider != nil && text != "" {
+		r.resultsCount.Text = resultsCountText(countProvider())
+		r.resultsCount.Color = r.searchBar.ResultsCountColor
+		r.resultsCount.TextSize = r.searchBar.FontSize - 2
+		r.resultsCount.Show()
+	} else {
+		r.resultsCount.Hide()
+	}
+
 	r.cancelBtn.SetText(r.searchBar.CancelButtonTitle)
+	r.Layout(r.searchBar.Size())
 
 	r.background.Refresh()
 	r.textFieldBg.Refresh()
 	r.searchIcon.Refresh()
 	r.entry.Refresh()
+	r.resultsCount.Refresh()
 	r.cancelBtn.Refresh()
 }
 
@@ -291,10 +364,20 @@ func (r *searchBarRenderer) Objects() []fyne.CanvasObject {
 		r.textFieldBg,
 		r.searchIcon,
 		r.entry,
+		r.resultsCount,
+		r.clearBtn,
 		r.cancelBtn,
 	}
 }
 
+// resultsCountText formats a results count hint, such as "(12 results)"
+func resultsCountText(count int) string {
+	if count == 1 {
+		return "(1 result)"
+	}
+	return fmt.Sprintf("(%d results)", count)
+}
+
 // SearchController manages search presentation
 type SearchController struct {
 	SearchBar         *SearchBar
@@ -3,8 +3,10 @@
 package segmented
 
 import (
+	"fmt"
 	"image/color"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -36,13 +38,26 @@ type SegmentedControl struct {
 	SegmentSpacing        float32
 	TextSize              float32
 
+	// Momentary makes the segments a button group: a tap briefly highlights
+	// its segment and calls OnValueChanged, but no selection is kept
+	Momentary bool
+
 	// Callbacks
 	OnValueChanged func(selectedIndex int)
 
+	// AccessibilityLabel is announced by screen readers before the selection
+	AccessibilityLabel string
+
 	mu          sync.RWMutex
 	hoveredIndex int
+	flashIndex   int
+	disabled     map[int]bool
 }
 
+// momentaryFlashDuration is how long a tapped momentary segment stays
+// highlighted
+const momentaryFlashDuration = 150 * time.Millisecond
+
 // NewSegmentedControl creates a new segmented control
 func NewSegmentedControl(segments []string, onValueChanged func(selectedIndex int)) *SegmentedControl {
 	config := core.SharedConfiguration()
@@ -62,14 +77,23 @@ func NewSegmentedControl(segments []string, onValueChanged func(selectedIndex in
 		TextSize:              theme.TextSize(),
 		OnValueChanged:        onValueChanged,
 		hoveredIndex:          -1,
+		flashIndex:            -1,
 	}
 	sc.ExtendBaseWidget(sc)
 	return sc
 }
 
-// SetSelectedIndex sets the selected segment index
+// SetSelectedIndex sets the selected segment index. An index of -1 clears
+// the selection without calling OnValueChanged.
 func (sc *SegmentedControl) SetSelectedIndex(index int) {
-	if index < 0 || index >= len(sc.Segments) {
+	if index == -1 {
+		sc.mu.Lock()
+		sc.SelectedIndex = -1
+		sc.mu.Unlock()
+		sc.Refresh()
+		return
+	}
+	if index < 0 || index >= len(sc.Segments) || !sc.IsSegmentEnabled(index) {
 		return
 	}
 	sc.mu.Lock()
@@ -81,6 +105,82 @@ func (sc *SegmentedControl) SetSelectedIndex(index int) {
 	}
 }
 
+// SetSegmentEnabled enables or disables the segment at index. A disabled
+// segment is dimmed, ignores taps and cannot be selected.
+func (sc *SegmentedControl) SetSegmentEnabled(index int, enabled bool) {
+	sc.mu.Lock()
+	if index < 0 || index >= len(sc.Segments) {
+		sc.mu.Unlock()
+		return
+	}
+	if enabled {
+		delete(sc.disabled, index)
+	} else {
+		if sc.disabled == nil {
+			sc.disabled = make(map[int]bool)
+		}
+		sc.disabled[index] = true
+	}
+	sc.mu.Unlock()
+	sc.Refresh()
+}
+
+// IsSegmentEnabled returns whether the segment at index can be selected
+func (sc *SegmentedControl) IsSegmentEnabled(index int) bool {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return !sc.disabled[index]
+}
+
+// SelectNextSegment selects the next enabled segment after the selection,
+// returning whether the selection moved
+func (sc *SegmentedControl) SelectNextSegment() bool {
+	return sc.advanceSelection(1)
+}
+
+// SelectPreviousSegment selects the closest enabled segment before the
+// selection, returning whether the selection moved
+func (sc *SegmentedControl) SelectPreviousSegment() bool {
+	return sc.advanceSelection(-1)
+}
+
+// advanceSelection selects the first enabled segment step by step from the
+// selection, skipping disabled segments and stopping at either end
+func (sc *SegmentedControl) advanceSelection(step int) bool {
+	sc.mu.RLock()
+	index := sc.SelectedIndex + step
+	for index >= 0 && index < len(sc.Segments) && sc.disabled[index] {
+		index += step
+	}
+	count := len(sc.Segments)
+	sc.mu.RUnlock()
+
+	if index < 0 || index >= count {
+		return false
+	}
+	sc.SetSelectedIndex(index)
+	return true
+}
+
+// shiftDisabled moves the disabled state of the segments from index on by
+// delta, keeping it attached to its segment when segments are inserted or
+// removed. Called with mu held.
+func (sc *SegmentedControl) shiftDisabled(index, delta int) {
+	if len(sc.disabled) == 0 {
+		return
+	}
+	shifted := make(map[int]bool, len(sc.disabled))
+	for i := range sc.disabled {
+		if i >= index {
+			i += delta
+		}
+		if i >= 0 {
+			shifted[i] = true
+		}
+	}
+	sc.disabled = shifted
+}
+
 // GetSelectedIndex returns the currently selected index
 func (sc *SegmentedControl) GetSelectedIndex() int {
 	sc.mu.RLock()
@@ -108,6 +208,7 @@ func (sc *SegmentedControl) InsertSegment(title string, index int) {
 		index = len(sc.Segments)
 	}
 	sc.Segments = append(sc.Segments[:index], append([]string{title}, sc.Segments[index:]...)...)
+	sc.shiftDisabled(index, 1)
 	sc.mu.Unlock()
 	sc.Refresh()
 }
@@ -120,6 +221,8 @@ func (sc *SegmentedControl) RemoveSegment(index int) {
 		return
 	}
 	sc.Segments = append(sc.Segments[:index], sc.Segments[index+1:]...)
+	delete(sc.disabled, index)
+	sc.shiftDisabled(index+1, -1)
 	if sc.SelectedIndex >= len(sc.Segments) {
 		sc.SelectedIndex = len(sc.Segments) - 1
 	}
@@ -251,11 +354,22 @@ func (r *segmentedRenderer) Refresh() {
 
 	r.control.mu.RLock()
 	selectedIndex := r.control.SelectedIndex
+	if r.control.Momentary {
+		selectedIndex = r.control.flashIndex
+	}
 	hoveredIndex := r.control.hoveredIndex
+	disabled := make(map[int]bool, len(r.control.disabled))
+	for i := range r.control.disabled {
+		disabled[i] = true
+	}
 	r.control.mu.RUnlock()
+	disabledAlpha := core.SharedConfiguration().ControlDisabledAlpha
 
 	for i, seg := range r.segments {
-		if i == selectedIndex {
+		if disabled[i] {
+			seg.background.FillColor = color.Transparent
+			seg.label.Color = core.ColorWithAlpha(r.control.TextColor, disabledAlpha)
+		} else if i == selectedIndex {
 			seg.background.FillColor = r.control.SelectedBackgroundColor
 			seg.label.Color = r.control.SelectedTextColor
 		} else if i == hoveredIndex {
@@ -297,14 +411,68 @@ func (r *segmentedRenderer) Objects() []fyne.CanvasObject {
 // Tapped handles tap events
 func (sc *SegmentedControl) Tapped(e *fyne.PointEvent) {
 	index := sc.indexAtPosition(e.Position)
+	if index >= 0 && !sc.IsSegmentEnabled(index) {
+		return
+	}
+	if index >= 0 && index < len(sc.Segments) && sc.Momentary {
+		sc.flash(index)
+		core.Feedback(core.FeedbackTap)
+		if sc.OnValueChanged != nil {
+			sc.OnValueChanged(index)
+		}
+		return
+	}
 	if index >= 0 && index < len(sc.Segments) {
+		changed := index != sc.GetSelectedIndex()
 		sc.SetSelectedIndex(index)
+		if changed {
+			core.Feedback(core.FeedbackSelectionChanged)
+		}
 	}
 }
 
 // TappedSecondary handles secondary tap
 func (sc *SegmentedControl) TappedSecondary(_ *fyne.PointEvent) {}
 
+// flash highlights a tapped momentary segment until momentaryFlashDuration
+// has passed
+func (sc *SegmentedControl) flash(index int) {
+	sc.mu.Lock()
+	sc.flashIndex = index
+	sc.mu.Unlock()
+	sc.Refresh()
+
+	time.AfterFunc(momentaryFlashDuration, func() {
+		fyne.Do(func() {
+			sc.mu.Lock()
+			if sc.flashIndex != index {
+				sc.mu.Unlock()
+				return
+			}
+			sc.flashIndex = -1
+			sc.mu.Unlock()
+			sc.Refresh()
+		})
+	})
+}
+
+// AccessibilityDescription implements core.Accessible, announcing the
+// selected segment and its position, such as "Two, 2 of 3"
+func (sc *SegmentedControl) AccessibilityDescription() core.AccessibilityDescription {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	var value string
+	if sc.SelectedIndex >= 0 && sc.SelectedIndex < len(sc.Segments) && !sc.Momentary {
+		value = fmt.Sprintf("%s, %d of %d", sc.Segments[sc.SelectedIndex], sc.SelectedIndex+1, len(sc.Segments))
+	}
+	return core.AccessibilityDescription{
+		Role:  core.AccessibilityRoleSegmentedControl,
+		Label: sc.AccessibilityLabel,
+		Value: value,
+	}
+}
+
 // MouseIn handles mouse enter
 func (sc *SegmentedControl) MouseIn(e *desktop.MouseEvent) {
 	index := sc.indexAtPosition(e.Position)
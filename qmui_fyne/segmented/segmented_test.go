@@ -1,12 +1,25 @@
 package segmented
 
 import (
+	"image/color"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/test"
 )
 
+// waitFor polls cond until it holds or a second has passed, returning
+// whether it held
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for !cond() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return cond()
+}
+
 func TestSegmentedControl_VisualRendering(t *testing.T) {
 	var selectedIndex int
 	sc := NewSegmentedControl([]string{"One", "Two", "Three"}, func(idx int) {
@@ -83,3 +96,125 @@ func TestSegmentedControl_WidthDistribution(t *testing.T) {
 	t.Logf("SegmentedControl min size: %v", minSize)
 	w.Close()
 }
+
+func TestSegmentedControl_Momentary(t *testing.T) {
+	var tapped []int
+	sc := NewPillSegmentedControl([]string{"B", "I", "U"}, func(idx int) {
+		tapped = append(tapped, idx)
+	})
+	sc.Momentary = true
+
+	w := test.NewWindow(sc.SegmentedControl)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 50))
+
+	segmentColor := func(i int) color.Color {
+		return test.WidgetRenderer(sc.SegmentedControl).Objects()[1+i*3].(*canvas.Rectangle).FillColor
+	}
+
+	sc.Tapped(&fyne.PointEvent{Position: fyne.NewPos(150, 25)})
+	sc.Tapped(&fyne.PointEvent{Position: fyne.NewPos(150, 25)})
+	if len(tapped) != 2 || tapped[0] != 1 || tapped[1] != 1 {
+		t.Errorf("Each momentary tap should fire the callback, got %v", tapped)
+	}
+	if segmentColor(1) != sc.SelectedBackgroundColor {
+		t.Error("Tapped momentary segment should highlight briefly")
+	}
+	if segmentColor(0) == sc.SelectedBackgroundColor {
+		t.Error("Momentary control should not show the initial selection")
+	}
+
+	if !waitFor(func() bool { return segmentColor(1) != sc.SelectedBackgroundColor }) {
+		t.Error("Momentary highlight should clear after the flash")
+	}
+}
+
+func TestSegmentedControl_ClearSelection(t *testing.T) {
+	calls := 0
+	sc := NewSegmentedControl([]string{"A", "B"}, func(int) { calls++ })
+
+	sc.SetSelectedIndex(-1)
+	if sc.GetSelectedIndex() != -1 || sc.GetSelectedSegment() != "" {
+		t.Errorf("SetSelectedIndex(-1) should clear the selection, got %d", sc.GetSelectedIndex())
+	}
+	if calls != 0 {
+		t.Error("Clearing the selection should not call OnValueChanged")
+	}
+}
+
+func TestSegmentedControl_SegmentEnabled(t *testing.T) {
+	sc := NewSegmentedControl([]string{"Free", "Pro", "Team"}, nil)
+	w := test.NewWindow(sc)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 50))
+
+	sc.SetSegmentEnabled(1, false)
+	sc.Tapped(&fyne.PointEvent{Position: fyne.NewPos(150, 25)})
+	sc.SetSelectedIndex(1)
+	if sc.GetSelectedIndex() != 0 {
+		t.Errorf("A disabled segment should not be selectable, got %d", sc.GetSelectedIndex())
+	}
+
+	label := test.WidgetRenderer(sc).Objects()[1+1*3+1].(*canvas.Text)
+	if label.Color == sc.TextColor {
+		t.Error("A disabled segment should be dimmed")
+	}
+
+	if !sc.SelectNextSegment() || sc.GetSelectedIndex() != 2 {
+		t.Errorf("Advancing should skip the disabled segment, got %d", sc.GetSelectedIndex())
+	}
+	if sc.SelectNextSegment() {
+		t.Error("Advancing past the last segment should do nothing")
+	}
+
+	sc.InsertSegment("Basic", 0)
+	if !sc.IsSegmentEnabled(1) || sc.IsSegmentEnabled(2) {
+		t.Error("The disabled state should move with its segment when segments are inserted")
+	}
+	sc.SetSegmentEnabled(2, true)
+	sc.SetSelectedIndex(2)
+	if sc.GetSelectedIndex() != 2 {
+		t.Error("A re-enabled segment should be selectable")
+	}
+}
+
+func TestSegmentedContentSwitcher_SwapsContent(t *testing.T) {
+	day := canvas.NewRectangle(color.Black)
+	week := canvas.NewRectangle(color.White)
+	month := canvas.NewRectangle(color.Black)
+	s := NewSegmentedContentSwitcher([]string{"Day", "Week", "Month"}, []fyne.CanvasObject{day, week, month})
+
+	var changed []int
+	s.OnSelectionChanged = func(index int) {
+		changed = append(changed, index)
+	}
+
+	w := test.NewWindow(s)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 200))
+
+	if s.CurrentContent() != day || !day.Visible() || week.Visible() || month.Visible() {
+		t.Fatal("Only the first panel should show at first")
+	}
+	if day.Position().Y <= s.Control.Position().Y+s.Control.Size().Height-1 {
+		t.Errorf("The panel should sit below the control, got y %v", day.Position().Y)
+	}
+
+	s.SetSelectedIndex(1)
+	if s.CurrentContent() != week || day.Visible() || !week.Visible() {
+		t.Error("Selecting a segment should show its panel")
+	}
+	if len(changed) != 1 || changed[0] != 1 {
+		t.Errorf("OnSelectionChanged should report 1, got %v", changed)
+	}
+
+	s.CrossFade = true
+	s.FadeDuration = 40 * time.Millisecond
+	s.SetSelectedIndex(2)
+	if !s.IsFading() {
+		t.Error("A cross-fade should be running after the selection changes")
+	}
+	if !waitFor(func() bool { return !s.IsFading() }) || s.CurrentContent() != month || !month.Visible() || week.Visible() {
+		t.Error("The cross-fade should end on the new panel")
+	}
+}
@@ -0,0 +1,256 @@
+package segmented
+
+import (
+	"image/color"
+	"math"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/paul-hammant/qmui_fyne/animation"
+	"github.com/paul-hammant/qmui_fyne/core"
+)
+
+// SegmentedContentSwitcher shows a segmented control above a content area,
+// displaying the panel of the selected segment, as a Day/Week/Month picker
+// does
+type SegmentedContentSwitcher struct {
+	widget.BaseWidget
+
+	// Control is the segmented control that picks the panel
+	Control *SegmentedControl
+
+	// CrossFade fades the old panel out to BackgroundColor and the new one
+	// in, taking FadeDuration, instead of swapping them at once
+	CrossFade    bool
+	FadeDuration time.Duration
+
+	// Styling
+	BackgroundColor color.Color
+	Spacing         float32
+
+	// OnSelectionChanged is called with the index of the newly selected panel
+	OnSelectionChanged func(index int)
+
+	mu        sync.RWMutex
+	contents  []fyne.CanvasObject
+	shown     int
+	fade      *animation.Animation
+	fadeAlpha float64
+}
+
+// NewSegmentedContentSwitcher creates a switcher with a segment for each
+// title, showing the content at the same index when it is selected
+func NewSegmentedContentSwitcher(titles []string, contents []fyne.CanvasObject) *SegmentedContentSwitcher {
+	s := &SegmentedContentSwitcher{
+		FadeDuration:    time.Millisecond * 250,
+		BackgroundColor: core.SharedConfiguration().BackgroundColor,
+		Spacing:         theme.Padding() * 2,
+		contents:        contents,
+	}
+	s.Control = NewSegmentedControl(titles, s.selectionChanged)
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// SelectedIndex returns the index of the selected panel
+func (s *SegmentedContentSwitcher) SelectedIndex() int {
+	return s.Control.GetSelectedIndex()
+}
+
+// SetSelectedIndex selects the segment and panel at index
+func (s *SegmentedContentSwitcher) SetSelectedIndex(index int) {
+	s.Control.SetSelectedIndex(index)
+}
+
+// CurrentContent returns the panel being displayed, or nil if there is none
+func (s *SegmentedContentSwitcher) CurrentContent() fyne.CanvasObject {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.contentLocked(s.shown)
+}
+
+// IsFading returns whether a cross-fade is running
+func (s *SegmentedContentSwitcher) IsFading() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.fade != nil
+}
+
+func (s *SegmentedContentSwitcher) contentLocked(index int) fyne.CanvasObject {
+	if index < 0 || index >= len(s.contents) {
+		return nil
+	}
+	return s.contents[index]
+}
+
+// selectionChanged swaps to the panel of the selected segment, fading
+// through the background when CrossFade is set
+func (s *SegmentedContentSwitcher) selectionChanged(index int) {
+	s.mu.Lock()
+	if s.fade != nil {
+		s.fade.Stop()
+		s.fade = nil
+	}
+	if !s.CrossFade {
+		s.shown = index
+		s.fadeAlpha = 0
+		s.mu.Unlock()
+		s.Refresh()
+		s.notifySelectionChanged(index)
+		return
+	}
+
+	// The cover fades in over the old panel, which is swapped for the new
+	// one halfway, then fades out again
+	var fade *animation.Animation
+	fade = animation.NewAnimation(s.FadeDuration, animation.EaseInOutQuad, func(progress float64) {
+		s.mu.Lock()
+		if s.fade != fade {
+			s.mu.Unlock()
+			return
+		}
+		if progress >= 0.5 {
+			s.shown = index
+		}
+		s.fadeAlpha = 1 - math.Abs(2*progress-1)
+		s.mu.Unlock()
+		fyne.Do(s.Refresh)
+	})
+	fade.OnComplete = func() {
+		s.mu.Lock()
+		if s.fade != fade {
+			s.mu.Unlock()
+			return
+		}
+		s.fade = nil
+		s.shown = index
+		s.fadeAlpha = 0
+		s.mu.Unlock()
+		fyne.Do(s.Refresh)
+	}
+	s.fade = fade
+	s.mu.Unlock()
+
+	fade.Start()
+	s.notifySelectionChanged(index)
+}
+
+func (s *SegmentedContentSwitcher) notifySelectionChanged(index int) {
+	if s.OnSelectionChanged != nil {
+		s.OnSelectionChanged(index)
+	}
+}
+
+// CreateRenderer implements fyne.Widget
+func (s *SegmentedContentSwitcher) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &contentSwitcherRenderer{
+		switcher:   s,
+		background: canvas.NewRectangle(s.BackgroundColor),
+		cover:      canvas.NewRectangle(color.Transparent),
+	}
+	r.Refresh()
+	return r
+}
+
+type contentSwitcherRenderer struct {
+	switcher   *SegmentedContentSwitcher
+	background *canvas.Rectangle
+	cover      *canvas.Rectangle
+}
+
+func (r *contentSwitcherRenderer) Destroy() {
+	r.switcher.mu.Lock()
+	if r.switcher.fade != nil {
+		r.switcher.fade.Stop()
+		r.switcher.fade = nil
+	}
+	r.switcher.mu.Unlock()
+}
+
+func (r *contentSwitcherRenderer) contents() []fyne.CanvasObject {
+	r.switcher.mu.RLock()
+	defer r.switcher.mu.RUnlock()
+	return r.switcher.contents
+}
+
+func (r *contentSwitcherRenderer) Layout(size fyne.Size) {
+	r.background.Resize(size)
+
+	control := r.switcher.Control
+	controlHeight := control.MinSize().Height
+	control.Resize(fyne.NewSize(size.Width, controlHeight))
+	control.Move(fyne.NewPos(0, 0))
+
+	top := controlHeight + r.switcher.Spacing
+	contentSize := fyne.NewSize(size.Width, size.Height-top)
+	for _, content := range r.contents() {
+		if content == nil {
+			continue
+		}
+		content.Resize(contentSize)
+		content.Move(fyne.NewPos(0, top))
+	}
+	r.cover.Resize(contentSize)
+	r.cover.Move(fyne.NewPos(0, top))
+}
+
+func (r *contentSwitcherRenderer) MinSize() fyne.Size {
+	min := r.switcher.Control.MinSize()
+	var contentMin fyne.Size
+	for _, content := range r.contents() {
+		if content != nil {
+			contentMin = contentMin.Max(content.MinSize())
+		}
+	}
+	return fyne.NewSize(
+		fyne.Max(min.Width, contentMin.Width),
+		min.Height+r.switcher.Spacing+contentMin.Height,
+	)
+}
+
+func (r *contentSwitcherRenderer) Refresh() {
+	r.switcher.mu.RLock()
+	shown := r.switcher.shown
+	alpha := r.switcher.fadeAlpha
+	contents := r.switcher.contents
+	r.switcher.mu.RUnlock()
+
+	r.background.FillColor = r.switcher.BackgroundColor
+	r.background.Refresh()
+
+	for i, content := range contents {
+		if content == nil {
+			continue
+		}
+		if i == shown {
+			content.Show()
+		} else {
+			content.Hide()
+		}
+	}
+
+	if alpha > 0 {
+		r.cover.FillColor = core.ColorWithAlpha(r.switcher.BackgroundColor, alpha)
+		r.cover.Show()
+	} else {
+		r.cover.Hide()
+	}
+	r.cover.Refresh()
+	r.Layout(r.switcher.Size())
+}
+
+func (r *contentSwitcherRenderer) Objects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.background, r.switcher.Control}
+	for _, content := range r.contents() {
+		if content != nil {
+			objects = append(objects, content)
+		}
+	}
+	return append(objects, r.cover)
+}
@@ -4,14 +4,18 @@ package table
 import (
 	"image/color"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
+	"github.com/paul-hammant/qmui_fyne/progress"
+	"github.com/paul-hammant/qmui_fyne/qmuiswitch"
 )
 
 // TableStyle defines the table view style
@@ -66,7 +70,8 @@ type TableCell struct {
 	Image      fyne.Resource
 	Style      CellStyle
 
-	// Accessory
+	// Accessory. AccessoryView, when set, replaces the AccessoryType accessory
+	// and receives its own taps.
 	AccessoryType CellAccessoryType
 	AccessoryView fyne.CanvasObject
 
@@ -79,6 +84,7 @@ type TableCell struct {
 	SeparatorInsets     core.EdgeInsets
 	ContentInsets       core.EdgeInsets
 	ImageSize           fyne.Size
+	ImageCornerRadius   float32
 	TextFontSize        float32
 	DetailTextFontSize  float32
 	Height              float32
@@ -92,11 +98,41 @@ type TableCell struct {
 	OnAccessoryTapped   func()
 	OnSwitchChanged     func(on bool)
 
-	mu      sync.RWMutex
-	hovered bool
-	switchOn bool
+	mu          sync.RWMutex
+	hovered     bool
+	highlighted bool
+	switchOn    bool
+	onSelect    func()
+	canSelect   func() bool // Checked on each tap, as the table's flags change
+
+	// keyboardFocused is set, by the owning table, on the row keyboard
+	// navigation is on
+	keyboardFocused bool
+
+	// The owning table and the index path it shows the cell at. The table
+	// wires the callbacks once and they read the current path.
+	table *Table
+	path  indexPath
+
+	// Separator placement, wired in by the owning table
+	inTable         bool
+	tableSeparator  core.EdgeInsets
+	separatorHidden bool
+
+	// Editing mode, wired in by the owning table
+	editing      bool
+	onDelete     func()
+	onReorder    func(dy float32)
+	onReorderEnd func()
 }
 
+// cellSelectionFlashDuration is how long a tapped cell shows its selected
+// background by default, see Table.SelectionFlashDuration
+const cellSelectionFlashDuration = 150 * time.Millisecond
+
+// cellImageSpacing is the gap between a cell's image and its text
+const cellImageSpacing = 12
+
 // NewTableCell creates a new table view cell
 func NewTableCell(style CellStyle) *TableCell {
 	config := core.SharedConfiguration()
@@ -134,6 +170,16 @@ func NewTableCellWithTextAndDetail(text, detail string) *TableCell {
 	return cell
 }
 
+// NewTableCellWithImage creates a cell showing image before its title, with
+// detail as a subtitle below it
+func NewTableCellWithImage(image fyne.Resource, title, detail string) *TableCell {
+	cell := NewTableCell(CellStyleSubtitle)
+	cell.Image = image
+	cell.Text = title
+	cell.DetailText = detail
+	return cell
+}
+
 // SetSelected sets the selected state
 func (c *TableCell) SetSelected(selected bool) {
 	c.mu.Lock()
@@ -149,11 +195,9 @@ func (c *TableCell) CreateRenderer() fyne.WidgetRenderer {
 	background := canvas.NewRectangle(c.BackgroundColor)
 	separator := canvas.NewRectangle(c.SeparatorColor)
 
-	var image *canvas.Image
-	if c.Image != nil {
-		image = canvas.NewImageFromResource(c.Image)
-		image.FillMode = canvas.ImageFillContain
-	}
+	image := canvas.NewImageFromResource(c.Image)
+	image.FillMode = canvas.ImageFillContain
+	image.CornerRadius = c.ImageCornerRadius
 
 	textLabel := canvas.NewText(c.Text, c.TextColor)
 	textLabel.TextSize = c.TextFontSize
@@ -161,14 +205,68 @@ func (c *TableCell) CreateRenderer() fyne.WidgetRenderer {
 	detailLabel := canvas.NewText(c.DetailText, c.DetailTextColor)
 	detailLabel.TextSize = c.DetailTextFontSize
 
-	return &cellRenderer{
+	r := &cellRenderer{
 		cell:        c,
 		background:  background,
 		separator:   separator,
 		image:       image,
 		textLabel:   textLabel,
 		detailLabel: detailLabel,
+		deleteControl: newDeleteControl(func() {
+			c.mu.RLock()
+			onDelete := c.onDelete
+			c.mu.RUnlock()
+			if onDelete != nil {
+				onDelete()
+			}
+		}),
+		reorderHandle: newReorderHandle(func(dy float32) {
+			c.mu.RLock()
+			onReorder := c.onReorder
+			c.mu.RUnlock()
+			if onReorder != nil {
+				onReorder(dy)
+			}
+		}, func() {
+			c.mu.RLock()
+			onReorderEnd := c.onReorderEnd
+			c.mu.RUnlock()
+			if onReorderEnd != nil {
+				onReorderEnd()
+			}
+		}),
 	}
+	r.updateAccessory()
+	return r
+}
+
+// SetAccessoryView sets a custom trailing accessory, such as a switch or button
+func (c *TableCell) SetAccessoryView(view fyne.CanvasObject) {
+	c.AccessoryView = view
+	c.Refresh()
+}
+
+// SetSwitchOn sets the state of the CellAccessorySwitch accessory
+func (c *TableCell) SetSwitchOn(on bool) {
+	c.mu.Lock()
+	c.switchOn = on
+	c.mu.Unlock()
+	c.Refresh()
+}
+
+// IsSwitchOn returns the state of the CellAccessorySwitch accessory
+func (c *TableCell) IsSwitchOn() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.switchOn
+}
+
+// setHighlighted sets the transient tap highlight
+func (c *TableCell) setHighlighted(highlighted bool) {
+	c.mu.Lock()
+	c.highlighted = highlighted
+	c.mu.Unlock()
+	c.Refresh()
 }
 
 // Tapped handles tap events
@@ -179,6 +277,28 @@ func (c *TableCell) Tapped(_ *fyne.PointEvent) {
 	if c.OnTapped != nil {
 		c.OnTapped()
 	}
+
+	if c.selectable() {
+		c.mu.RLock()
+		onSelect := c.onSelect
+		c.mu.RUnlock()
+		onSelect()
+	}
+}
+
+// tablePath returns the section and row the owning table shows the cell at
+func (c *TableCell) tablePath() (section, row int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.path.section, c.path.row
+}
+
+// selectable returns whether tapping the cell selects it in its table
+func (c *TableCell) selectable() bool {
+	c.mu.RLock()
+	onSelect, canSelect := c.onSelect, c.canSelect
+	c.mu.RUnlock()
+	return onSelect != nil && (canSelect == nil || canSelect())
 }
 
 // TappedSecondary handles secondary tap
@@ -205,7 +325,7 @@ func (c *TableCell) MouseOut() {
 
 // Cursor returns the cursor for this widget
 func (c *TableCell) Cursor() desktop.Cursor {
-	if c.Enabled {
+	if c.Enabled && (c.selectable() || c.OnTapped != nil) {
 		return desktop.PointerCursor
 	}
 	return desktop.DefaultCursor
@@ -219,15 +339,59 @@ type cellRenderer struct {
 	textLabel   *canvas.Text
 	detailLabel *canvas.Text
 	accessory   fyne.CanvasObject
+
+	accessoryType CellAccessoryType
+	accessoryView fyne.CanvasObject
+	deleteControl *deleteControl
+	reorderHandle *reorderHandle
+}
+
+// isEditing reports whether the owning table is in editing mode
+func (r *cellRenderer) isEditing() bool {
+	r.cell.mu.RLock()
+	defer r.cell.mu.RUnlock()
+	return r.cell.editing
 }
 
 func (r *cellRenderer) Destroy() {}
 
+// updateAccessory rebuilds the trailing accessory when the cell's
+// AccessoryView or AccessoryType has changed
+func (r *cellRenderer) updateAccessory() {
+	if r.cell.AccessoryView != nil || r.accessoryView != nil {
+		if r.accessoryView == r.cell.AccessoryView {
+			return
+		}
+		r.accessoryView = r.cell.AccessoryView
+		r.accessory = r.accessoryView
+		if r.accessory != nil {
+			return
+		}
+		// The custom view was removed; fall back to AccessoryType
+		r.accessoryType = r.cell.AccessoryType
+		r.accessory = newCellAccessory(r.cell)
+		return
+	}
+	if r.accessory != nil && r.accessoryType == r.cell.AccessoryType {
+		if sw, ok := r.accessory.(*qmuiswitch.Switch); ok {
+			sw.Checked = r.cell.IsSwitchOn()
+		}
+		return
+	}
+	r.accessoryType = r.cell.AccessoryType
+	r.accessory = newCellAccessory(r.cell)
+}
+
 func (r *cellRenderer) Layout(size fyne.Size) {
 	r.background.Resize(size)
 
 	// Separator at bottom
-	sepInsets := r.cell.SeparatorInsets
+	sepInsets, hidden := r.cell.separatorLayout()
+	if r.cell.Image != nil && sepInsets.Left > 0 {
+		// An inset separator starts at the text, past the image
+		sepInsets.Left += r.cell.ImageSize.Width + cellImageSpacing
+	}
+	r.separator.Hidden = hidden
 	r.separator.Resize(fyne.NewSize(size.Width-sepInsets.Left-sepInsets.Right, 0.5))
 	r.separator.Move(fyne.NewPos(sepInsets.Left, size.Height-0.5))
 
@@ -235,16 +399,31 @@ func (r *cellRenderer) Layout(size fyne.Size) {
 	x := insets.Left
 	rightX := size.Width - insets.Right
 
+	// Editing controls replace the accessory
+	editing := r.isEditing()
+	if editing {
+		delSize := r.deleteControl.MinSize()
+		r.deleteControl.Resize(delSize)
+		r.deleteControl.Move(fyne.NewPos(x, (size.Height-delSize.Height)/2))
+		x += delSize.Width + 12
+
+		handleSize := r.reorderHandle.MinSize()
+		r.reorderHandle.Resize(handleSize)
+		r.reorderHandle.Move(fyne.NewPos(rightX-handleSize.Width, (size.Height-handleSize.Height)/2))
+		rightX -= handleSize.Width + 8
+	}
+
 	// Image
-	if r.image != nil && r.cell.Image != nil {
+	r.image.Hidden = r.cell.Image == nil
+	if r.cell.Image != nil {
 		imgSize := r.cell.ImageSize
 		r.image.Resize(imgSize)
 		r.image.Move(fyne.NewPos(x, (size.Height-imgSize.Height)/2))
-		x += imgSize.Width + 12
+		x += imgSize.Width + cellImageSpacing
 	}
 
 	// Accessory
-	if r.accessory != nil {
+	if r.accessory != nil && !editing {
 		accSize := r.accessory.MinSize()
 		r.accessory.Resize(accSize)
 		r.accessory.Move(fyne.NewPos(rightX-accSize.Width, (size.Height-accSize.Height)/2))
@@ -284,13 +463,26 @@ func (r *cellRenderer) MinSize() fyne.Size {
 	return fyne.NewSize(200, r.cell.Height)
 }
 
+// separatorLayout returns the separator insets and whether it is hidden. A
+// cell in a table takes the table's separator settings over its own.
+func (c *TableCell) separatorLayout() (core.EdgeInsets, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.inTable {
+		return c.tableSeparator, c.separatorHidden
+	}
+	return c.SeparatorInsets, false
+}
+
 func (r *cellRenderer) Refresh() {
 	r.cell.mu.RLock()
 	hovered := r.cell.hovered
+	highlighted := r.cell.highlighted
 	selected := r.cell.Selected
+	keyboardFocused := r.cell.keyboardFocused
 	r.cell.mu.RUnlock()
 
-	if selected || hovered {
+	if selected || hovered || highlighted || keyboardFocused {
 		r.background.FillColor = r.cell.SelectedBackgroundColor
 	} else {
 		r.background.FillColor = r.cell.BackgroundColor
@@ -306,11 +498,18 @@ func (r *cellRenderer) Refresh() {
 	r.detailLabel.Color = r.cell.DetailTextColor
 	r.detailLabel.TextSize = r.cell.DetailTextFontSize
 
-	if r.image != nil && r.cell.Image != nil {
+	if r.cell.Image != nil {
 		r.image.Resource = r.cell.Image
+		r.image.CornerRadius = r.cell.ImageCornerRadius
 		r.image.Refresh()
 	}
 
+	r.updateAccessory()
+	r.Layout(r.cell.Size())
+	if r.accessory != nil {
+		r.accessory.Refresh()
+	}
+
 	r.background.Refresh()
 	r.separator.Refresh()
 	r.textLabel.Refresh()
@@ -318,16 +517,318 @@ func (r *cellRenderer) Refresh() {
 }
 
 func (r *cellRenderer) Objects() []fyne.CanvasObject {
-	objects := []fyne.CanvasObject{r.background, r.separator, r.textLabel, r.detailLabel}
-	if r.image != nil {
-		objects = append(objects, r.image)
-	}
-	if r.accessory != nil {
+	objects := []fyne.CanvasObject{r.background, r.separator, r.textLabel, r.detailLabel, r.image}
+	if r.isEditing() {
+		objects = append(objects, r.deleteControl, r.reorderHandle)
+	} else if r.accessory != nil {
 		objects = append(objects, r.accessory)
 	}
 	return objects
 }
 
+// newCellAccessory creates the trailing accessory for the cell's AccessoryType
+func newCellAccessory(cell *TableCell) fyne.CanvasObject {
+	config := core.SharedConfiguration()
+
+	switch cell.AccessoryType {
+	case CellAccessoryDisclosureIndicator:
+		return newAccessoryGlyph(CellAccessoryDisclosureIndicator, config.GrayLightenColor)
+	case CellAccessoryCheckmark:
+		return newAccessoryGlyph(CellAccessoryCheckmark, config.BlueColor)
+	case CellAccessoryDetailButton:
+		return newDetailButton(config.BlueColor, func() {
+			if cell.OnAccessoryTapped != nil {
+				cell.OnAccessoryTapped()
+			}
+		})
+	case CellAccessorySwitch:
+		sw := qmuiswitch.NewSwitch(func(on bool) {
+			cell.mu.Lock()
+			cell.switchOn = on
+			cell.mu.Unlock()
+			if cell.OnSwitchChanged != nil {
+				cell.OnSwitchChanged(on)
+			}
+		})
+		sw.Checked = cell.IsSwitchOn()
+		return sw
+	}
+	return nil
+}
+
+// accessoryGlyph draws a disclosure chevron or checkmark. It is not tappable,
+// so taps on it fall through to the cell.
+type accessoryGlyph struct {
+	widget.BaseWidget
+
+	kind  CellAccessoryType
+	color color.Color
+}
+
+func newAccessoryGlyph(kind CellAccessoryType, c color.Color) *accessoryGlyph {
+	g := &accessoryGlyph{kind: kind, color: c}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+func (g *accessoryGlyph) CreateRenderer() fyne.WidgetRenderer {
+	line1 := canvas.NewLine(g.color)
+	line1.StrokeWidth = 2
+	line2 := canvas.NewLine(g.color)
+	line2.StrokeWidth = 2
+	return &accessoryGlyphRenderer{glyph: g, line1: line1, line2: line2}
+}
+
+type accessoryGlyphRenderer struct {
+	glyph *accessoryGlyph
+	line1 *canvas.Line
+	line2 *canvas.Line
+}
+
+func (r *accessoryGlyphRenderer) Destroy() {}
+
+func (r *accessoryGlyphRenderer) Layout(size fyne.Size) {
+	w, h := size.Width, size.Height
+	if r.glyph.kind == CellAccessoryCheckmark {
+		// Tick: short stroke down-right, long stroke up-right
+		r.line1.Position1 = fyne.NewPos(0, h*0.55)
+		r.line1.Position2 = fyne.NewPos(w*0.35, h*0.9)
+		r.line2.Position1 = fyne.NewPos(w*0.35, h*0.9)
+		r.line2.Position2 = fyne.NewPos(w, h*0.1)
+		return
+	}
+	// Chevron pointing right
+	r.line1.Position1 = fyne.NewPos(w*0.2, 0)
+	r.line1.Position2 = fyne.NewPos(w*0.8, h/2)
+	r.line2.Position1 = fyne.NewPos(w*0.8, h/2)
+	r.line2.Position2 = fyne.NewPos(w*0.2, h)
+}
+
+func (r *accessoryGlyphRenderer) MinSize() fyne.Size {
+	if r.glyph.kind == CellAccessoryCheckmark {
+		return fyne.NewSize(14, 11)
+	}
+	return fyne.NewSize(8, 13)
+}
+
+func (r *accessoryGlyphRenderer) Refresh() {
+	r.line1.StrokeColor = r.glyph.color
+	r.line2.StrokeColor = r.glyph.color
+	r.line1.Refresh()
+	r.line2.Refresh()
+}
+
+func (r *accessoryGlyphRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.line1, r.line2}
+}
+
+// detailButton is the circled "i" used by CellAccessoryDetailButton. It
+// consumes its own taps so they don't select the row.
+type detailButton struct {
+	widget.BaseWidget
+
+	color    color.Color
+	onTapped func()
+}
+
+func newDetailButton(c color.Color, onTapped func()) *detailButton {
+	b := &detailButton{color: c, onTapped: onTapped}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// Tapped handles tap events
+func (b *detailButton) Tapped(_ *fyne.PointEvent) {
+	if b.onTapped != nil {
+		b.onTapped()
+	}
+}
+
+// Cursor returns the cursor for this widget
+func (b *detailButton) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (b *detailButton) CreateRenderer() fyne.WidgetRenderer {
+	circle := canvas.NewCircle(color.Transparent)
+	circle.StrokeColor = b.color
+	circle.StrokeWidth = 1.5
+
+	text := canvas.NewText("i", b.color)
+	text.TextStyle = fyne.TextStyle{Bold: true}
+	text.TextSize = 13
+	text.Alignment = fyne.TextAlignCenter
+
+	return &detailButtonRenderer{button: b, circle: circle, text: text}
+}
+
+type detailButtonRenderer struct {
+	button *detailButton
+	circle *canvas.Circle
+	text   *canvas.Text
+}
+
+func (r *detailButtonRenderer) Destroy() {}
+
+func (r *detailButtonRenderer) Layout(size fyne.Size) {
+	r.circle.Resize(size)
+	textSize := r.text.MinSize()
+	r.text.Resize(fyne.NewSize(size.Width, textSize.Height))
+	r.text.Move(fyne.NewPos(0, (size.Height-textSize.Height)/2))
+}
+
+func (r *detailButtonRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(22, 22)
+}
+
+func (r *detailButtonRenderer) Refresh() {
+	r.circle.StrokeColor = r.button.color
+	r.text.Color = r.button.color
+	r.circle.Refresh()
+	r.text.Refresh()
+}
+
+func (r *detailButtonRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.circle, r.text}
+}
+
+// deleteControl is the red minus button shown at the leading edge of a cell
+// while its table is editing
+type deleteControl struct {
+	widget.BaseWidget
+
+	onTapped func()
+}
+
+func newDeleteControl(onTapped func()) *deleteControl {
+	d := &deleteControl{onTapped: onTapped}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+// Tapped handles tap events
+func (d *deleteControl) Tapped(_ *fyne.PointEvent) {
+	if d.onTapped != nil {
+		d.onTapped()
+	}
+}
+
+// Cursor returns the cursor for this widget
+func (d *deleteControl) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (d *deleteControl) CreateRenderer() fyne.WidgetRenderer {
+	config := core.SharedConfiguration()
+	circle := canvas.NewCircle(config.RedColor)
+	minus := canvas.NewLine(config.WhiteColor)
+	minus.StrokeWidth = 2
+	return &deleteControlRenderer{circle: circle, minus: minus}
+}
+
+type deleteControlRenderer struct {
+	circle *canvas.Circle
+	minus  *canvas.Line
+}
+
+func (r *deleteControlRenderer) Destroy() {}
+
+func (r *deleteControlRenderer) Layout(size fyne.Size) {
+	r.circle.Resize(size)
+	r.minus.Position1 = fyne.NewPos(size.Width*0.25, size.Height/2)
+	r.minus.Position2 = fyne.NewPos(size.Width*0.75, size.Height/2)
+}
+
+func (r *deleteControlRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(22, 22)
+}
+
+func (r *deleteControlRenderer) Refresh() {
+	config := core.SharedConfiguration()
+	r.circle.FillColor = config.RedColor
+	r.minus.StrokeColor = config.WhiteColor
+	r.circle.Refresh()
+	r.minus.Refresh()
+}
+
+func (r *deleteControlRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.circle, r.minus}
+}
+
+// reorderHandle is the three-line grip shown at the trailing edge of a cell
+// while its table is editing. Dragging it moves the row.
+type reorderHandle struct {
+	widget.BaseWidget
+
+	onDragged func(dy float32)
+	onDragEnd func()
+}
+
+func newReorderHandle(onDragged func(dy float32), onDragEnd func()) *reorderHandle {
+	h := &reorderHandle{onDragged: onDragged, onDragEnd: onDragEnd}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+// Dragged handles drag events
+func (h *reorderHandle) Dragged(ev *fyne.DragEvent) {
+	if h.onDragged != nil {
+		h.onDragged(ev.Dragged.DY)
+	}
+}
+
+// DragEnd handles the end of a drag
+func (h *reorderHandle) DragEnd() {
+	if h.onDragEnd != nil {
+		h.onDragEnd()
+	}
+}
+
+// Cursor returns the cursor for this widget
+func (h *reorderHandle) Cursor() desktop.Cursor {
+	return desktop.VResizeCursor
+}
+
+func (h *reorderHandle) CreateRenderer() fyne.WidgetRenderer {
+	config := core.SharedConfiguration()
+	r := &reorderHandleRenderer{}
+	for i := range r.lines {
+		r.lines[i] = canvas.NewLine(config.GrayColor)
+		r.lines[i].StrokeWidth = 1.5
+	}
+	return r
+}
+
+type reorderHandleRenderer struct {
+	lines [3]*canvas.Line
+}
+
+func (r *reorderHandleRenderer) Destroy() {}
+
+func (r *reorderHandleRenderer) Layout(size fyne.Size) {
+	for i, line := range r.lines {
+		y := size.Height * float32(i+1) / 4
+		line.Position1 = fyne.NewPos(0, y)
+		line.Position2 = fyne.NewPos(size.Width, y)
+	}
+}
+
+func (r *reorderHandleRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(22, 16)
+}
+
+func (r *reorderHandleRenderer) Refresh() {
+	config := core.SharedConfiguration()
+	for _, line := range r.lines {
+		line.StrokeColor = config.GrayColor
+		line.Refresh()
+	}
+}
+
+func (r *reorderHandleRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.lines[0], r.lines[1], r.lines[2]}
+}
+
 // TableHeaderFooterView represents a section header or footer
 type TableHeaderFooterView struct {
 	widget.BaseWidget
@@ -338,12 +839,15 @@ type TableHeaderFooterView struct {
 	ContentInsets   core.EdgeInsets
 	FontSize        float32
 	IsHeader        bool
+
+	// ContentView, when set, replaces Text inside the content insets
+	ContentView fyne.CanvasObject
 }
 
 // NewTableHeaderView creates a section header
 func NewTableHeaderView(text string) *TableHeaderFooterView {
 	config := core.SharedConfiguration()
-	return &TableHeaderFooterView{
+	view := &TableHeaderFooterView{
 		Text:            text,
 		TextColor:       config.TableViewSectionHeaderTextColor,
 		BackgroundColor: config.TableViewSectionHeaderBackgroundColor,
@@ -351,12 +855,14 @@ func NewTableHeaderView(text string) *TableHeaderFooterView {
 		FontSize:        config.TableViewSectionHeaderFontSize,
 		IsHeader:        true,
 	}
+	view.ExtendBaseWidget(view)
+	return view
 }
 
 // NewTableFooterView creates a section footer
 func NewTableFooterView(text string) *TableHeaderFooterView {
 	config := core.SharedConfiguration()
-	return &TableHeaderFooterView{
+	view := &TableHeaderFooterView{
 		Text:            text,
 		TextColor:       config.TableViewSectionFooterTextColor,
 		BackgroundColor: config.TableViewSectionFooterBackgroundColor,
@@ -364,6 +870,8 @@ func NewTableFooterView(text string) *TableHeaderFooterView {
 		FontSize:        config.TableViewSectionFooterFontSize,
 		IsHeader:        false,
 	}
+	view.ExtendBaseWidget(view)
+	return view
 }
 
 func (h *TableHeaderFooterView) CreateRenderer() fyne.WidgetRenderer {
@@ -391,15 +899,26 @@ func (r *headerFooterRenderer) Destroy() {}
 func (r *headerFooterRenderer) Layout(size fyne.Size) {
 	r.background.Resize(size)
 	insets := r.view.ContentInsets
+	if content := r.view.ContentView; content != nil {
+		content.Resize(fyne.NewSize(
+			size.Width-insets.Left-insets.Right,
+			size.Height-insets.Top-insets.Bottom,
+		))
+		content.Move(fyne.NewPos(insets.Left, insets.Top))
+		return
+	}
 	r.text.Move(fyne.NewPos(insets.Left, insets.Top))
 }
 
 func (r *headerFooterRenderer) MinSize() fyne.Size {
-	textSize := r.text.MinSize()
+	contentSize := r.text.MinSize()
+	if r.view.ContentView != nil {
+		contentSize = r.view.ContentView.MinSize()
+	}
 	insets := r.view.ContentInsets
 	return fyne.NewSize(
-		textSize.Width+insets.Left+insets.Right,
-		textSize.Height+insets.Top+insets.Bottom,
+		contentSize.Width+insets.Left+insets.Right,
+		contentSize.Height+insets.Top+insets.Bottom,
 	)
 }
 
@@ -410,9 +929,16 @@ func (r *headerFooterRenderer) Refresh() {
 	r.text.TextSize = r.view.FontSize
 	r.background.Refresh()
 	r.text.Refresh()
+	if r.view.ContentView != nil {
+		r.Layout(r.view.Size())
+		r.view.ContentView.Refresh()
+	}
 }
 
 func (r *headerFooterRenderer) Objects() []fyne.CanvasObject {
+	if r.view.ContentView != nil {
+		return []fyne.CanvasObject{r.background, r.view.ContentView}
+	}
 	return []fyne.CanvasObject{r.background, r.text}
 }
 
@@ -421,6 +947,21 @@ type TableSection struct {
 	Header *TableHeaderFooterView
 	Footer *TableHeaderFooterView
 	Cells  []*TableCell
+
+	// HeaderView and FooterView are custom views that replace the plain
+	// header and footer, drawn with the section header/footer colors and insets
+	HeaderView fyne.CanvasObject
+	FooterView fyne.CanvasObject
+
+	// FooterTitle is plain footer text, used when Footer and FooterView are unset
+	FooterTitle string
+
+	// IndexTitle is the entry shown for this section in the section index
+	IndexTitle string
+
+	customHeader *TableHeaderFooterView
+	customFooter *TableHeaderFooterView
+	titleFooter  *TableHeaderFooterView
 }
 
 // NewTableSection creates a new table section
@@ -436,6 +977,39 @@ func (s *TableSection) AddCell(cell *TableCell) {
 	s.Cells = append(s.Cells, cell)
 }
 
+// headerView returns the view drawn above the section's cells, or nil
+func (s *TableSection) headerView() *TableHeaderFooterView {
+	if s.HeaderView == nil {
+		return s.Header
+	}
+	if s.customHeader == nil {
+		s.customHeader = NewTableHeaderView("")
+	}
+	s.customHeader.ContentView = s.HeaderView
+	return s.customHeader
+}
+
+// footerView returns the view drawn below the section's cells, or nil
+func (s *TableSection) footerView() *TableHeaderFooterView {
+	switch {
+	case s.FooterView != nil:
+		if s.customFooter == nil {
+			s.customFooter = NewTableFooterView("")
+		}
+		s.customFooter.ContentView = s.FooterView
+		return s.customFooter
+	case s.Footer != nil:
+		return s.Footer
+	case s.FooterTitle != "":
+		if s.titleFooter == nil {
+			s.titleFooter = NewTableFooterView("")
+		}
+		s.titleFooter.Text = s.FooterTitle
+		return s.titleFooter
+	}
+	return nil
+}
+
 // Table is an enhanced list/table view
 type Table struct {
 	widget.BaseWidget
@@ -449,9 +1023,87 @@ type Table struct {
 	CornerRadius      float32
 	HorizontalInset   float32
 
-	mu sync.RWMutex
+	// Separators. SeparatorInset replaces the cells' own SeparatorInsets and
+	// by default starts the separator at the cell text. SeparatorFullWidth
+	// draws separators edge to edge instead, and HidesLastSeparator drops the
+	// separator below the last row of each section.
+	SeparatorInset     core.EdgeInsets
+	SeparatorFullWidth bool
+	HidesLastSeparator bool
+
+	// Selection. A tapped cell shows its selected background for
+	// SelectionFlashDuration before OnCellSelected is called; 0 calls it at
+	// once.
+	AllowsSelection        bool
+	OnCellSelected         func(section, row int)
+	SelectionFlashDuration time.Duration
+
+	// Section index
+	ShowsSectionIndex bool
+
+	// StickyHeaders pins the current section's header to the top of the
+	// scroll until the next section pushes it up. It needs the table to be
+	// wrapped with NewScroll and is ignored by TableStyleInsetGrouped.
+	StickyHeaders bool
+
+	// Editing
+	OnRowMoved   func(section, from, to int)
+	OnRowDeleted func(section, row int)
+
+	// Pull-to-refresh. Dragging down past the top reveals a spinner and calls
+	// OnRefresh; the spinner hides once the handler calls done. It needs the
+	// table to be wrapped with NewScroll; otherwise drags are left to the
+	// enclosing scroll container.
+	RefreshEnabled bool
+	OnRefresh      func(done func())
+
+	// RowHeight is the height of every row when cells come from a data source
+	RowHeight float32
+
+	// EmptyView, typically an empty.EmptyState, is shown centered while the
+	// table has no visible cells
+	EmptyView fyne.CanvasObject
+
+	mu         sync.RWMutex
+	scroll     *container.Scroll
+	indexBar   *sectionIndexBar
+	filter     func(cell *TableCell) bool
+	editing    bool
+	pull       float32
+	refreshing bool
+	spinner    *progress.RingProgress
+	pullLayer  *tablePullLayer
+	dataSource *tableDataSource
+	realized   map[indexPath]*TableCell
+
+	// Data source section headers: the title of each section, the headers
+	// realized for the visible sections and the height they all share
+	titleForSection func(section int) string
+	realizedHeaders map[int]*TableHeaderFooterView
+	headerHeight    float32
+
+	// Keyboard navigation: the row highlighted while the table has focus
+	focused      bool
+	focusPath    indexPath
+	hasFocusPath bool
+}
+
+// tableDataSource supplies sections and cells on demand, see SetDataSource
+type tableDataSource struct {
+	numSections func() int
+	numRows     func(section int) int
+	cellForRow  func(section, row int) *TableCell
 }
 
+// indexPath identifies a row within a section
+type indexPath struct {
+	section, row int
+}
+
+// refreshControlHeight is the height of the spinner area revealed while
+// refreshing, and how far the table must be pulled to start a refresh
+const refreshControlHeight float32 = 60
+
 // NewTable creates a new table view
 func NewTable(style TableStyle) *Table {
 	config := core.SharedConfiguration()
@@ -460,6 +1112,10 @@ func NewTable(style TableStyle) *Table {
 		Sections:        make([]*TableSection, 0),
 		BackgroundColor: config.TableViewBackgroundColor,
 		SeparatorColor:  config.TableViewSeparatorColor,
+		SeparatorInset:  core.NewEdgeInsets(0, 16, 0, 0),
+		AllowsSelection: true,
+		SelectionFlashDuration: cellSelectionFlashDuration,
+		RowHeight:       config.TableViewCellNormalHeight,
 	}
 
 	if style == TableStyleInsetGrouped {
@@ -479,69 +1135,1147 @@ func (tv *Table) AddSection(section *TableSection) {
 	tv.Refresh()
 }
 
-// CreateRenderer implements fyne.Widget
-func (tv *Table) CreateRenderer() fyne.WidgetRenderer {
-	tv.ExtendBaseWidget(tv)
-	return &tableViewRenderer{table: tv}
+// SetDataSource makes the table ask for its rows instead of using Sections.
+// Cells are created by cellForRow only when their row scrolls into view, and
+// are laid out RowHeight apart. Call ReloadData when the data changes, and
+// SetTitleForSection to give the sections headers. A data source is not
+// filtered, so any filter is cleared; leave filtered out rows out of the
+// counts instead.
+func (tv *Table) SetDataSource(numSections func() int, numRows func(section int) int, cellForRow func(section, row int) *TableCell) {
+	tv.mu.Lock()
+	tv.dataSource = &tableDataSource{
+		numSections: numSections,
+		numRows:     numRows,
+		cellForRow:  cellForRow,
+	}
+	tv.filter = nil
+	tv.realized = nil
+	tv.realizedHeaders = nil
+	tv.mu.Unlock()
+	tv.Refresh()
 }
 
-type tableViewRenderer struct {
-	table   *Table
-	objects []fyne.CanvasObject
+// SetTitleForSection gives each data source section a header showing the
+// title returned for it. Sections with an empty title have no header.
+func (tv *Table) SetTitleForSection(titleForSection func(section int) string) {
+	tv.mu.Lock()
+	tv.titleForSection = titleForSection
+	tv.realizedHeaders = nil
+	tv.mu.Unlock()
+	tv.Refresh()
 }
 
-func (r *tableViewRenderer) Destroy() {}
+// ReloadData discards the cells realized from the data source and asks for
+// them again
+func (tv *Table) ReloadData() {
+	tv.mu.Lock()
+	tv.realized = nil
+	tv.realizedHeaders = nil
+	tv.mu.Unlock()
+	tv.Refresh()
+}
 
-func (r *tableViewRenderer) buildObjects() {
-	r.objects = nil
+// SetEmptyView sets the view shown while the table has no visible cells
+func (tv *Table) SetEmptyView(view fyne.CanvasObject) {
+	tv.mu.Lock()
+	tv.EmptyView = view
+	tv.mu.Unlock()
+	tv.Refresh()
+}
 
-	background := canvas.NewRectangle(r.table.BackgroundColor)
-	r.objects = append(r.objects, background)
+// currentDataSource returns the data source, or nil when Sections is used
+func (tv *Table) currentDataSource() *tableDataSource {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.dataSource
+}
 
-	r.table.mu.RLock()
-	sections := r.table.Sections
-	r.table.mu.RUnlock()
+// selectCell flashes the cell's selected background for
+// SelectionFlashDuration and then reports the selection through
+// OnCellSelected
+func (tv *Table) selectCell(cell *TableCell, section, row int) {
+	if tv.SelectionFlashDuration <= 0 {
+		if tv.OnCellSelected != nil {
+			tv.OnCellSelected(section, row)
+		}
+		return
+	}
+
+	cell.setHighlighted(true)
+	time.AfterFunc(tv.SelectionFlashDuration, func() {
+		fyne.Do(func() {
+			cell.setHighlighted(false)
+			if tv.OnCellSelected != nil {
+				tv.OnCellSelected(section, row)
+			}
+		})
+	})
+}
 
-	for _, section := range sections {
-		if section.Header != nil {
-			r.objects = append(r.objects, section.Header)
+// wireCell connects a cell's selection and editing controls to the table at
+// the given index path and applies the table's separator settings. last is
+// set for the last row of its section. The callbacks are only created the
+// first time a cell is wired, as this runs on every layout.
+func (tv *Table) wireCell(cell *TableCell, section, row int, last bool) {
+	editing := tv.IsEditing()
+
+	separator := tv.SeparatorInset
+	if tv.SeparatorFullWidth {
+		separator = core.EdgeInsets{}
+	}
+
+	path := indexPath{section: section, row: row}
+	tv.mu.RLock()
+	keyboardFocused := tv.focused && tv.hasFocusPath && tv.focusPath == path
+	tv.mu.RUnlock()
+
+	cell.mu.Lock()
+	if cell.table != tv {
+		cell.table = tv
+		cell.onSelect = func() {
+			section, row := cell.tablePath()
+			tv.selectCell(cell, section, row)
 		}
-		for _, cell := range section.Cells {
-			r.objects = append(r.objects, cell)
+		cell.canSelect = func() bool {
+			tv.mu.RLock()
+			defer tv.mu.RUnlock()
+			return tv.AllowsSelection && !tv.editing
 		}
-		if section.Footer != nil {
-			r.objects = append(r.objects, section.Footer)
+		cell.onDelete = func() {
+			tv.deleteRow(cell.tablePath())
+		}
+		cell.onReorder = func(dy float32) {
+			cell.Move(cell.Position().AddXY(0, dy))
+		}
+		cell.onReorderEnd = func() {
+			section, row := cell.tablePath()
+			tv.endRowDrag(cell, section, row)
 		}
 	}
+	cell.path = path
+	cell.keyboardFocused = keyboardFocused
+	cell.inTable = true
+	cell.tableSeparator = separator
+	cell.separatorHidden = last && tv.HidesLastSeparator
+	cell.editing = editing
+	cell.mu.Unlock()
 }
 
-func (r *tableViewRenderer) Layout(size fyne.Size) {
-	r.buildObjects()
+// SetEditing turns editing mode on or off. While editing, each cell shows a
+// delete control and a drag handle for reordering within its section.
+func (tv *Table) SetEditing(editing bool) {
+	tv.mu.Lock()
+	tv.editing = editing
+	tv.mu.Unlock()
+	tv.Refresh()
+}
 
-	if len(r.objects) == 0 {
+// IsEditing returns whether the table is in editing mode
+func (tv *Table) IsEditing() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.editing
+}
+
+// deleteRow removes a row from its section and reports it through
+// OnRowDeleted
+func (tv *Table) deleteRow(section, row int) {
+	if tv.currentDataSource() != nil {
+		// The data source owns the rows, so report and reload
+		if tv.OnRowDeleted != nil {
+			tv.OnRowDeleted(section, row)
+		}
+		tv.ReloadData()
+		return
+	}
+
+	tv.mu.Lock()
+	if section >= len(tv.Sections) || row >= len(tv.Sections[section].Cells) {
+		tv.mu.Unlock()
+		return
+	}
+	s := tv.Sections[section]
+	s.Cells = append(s.Cells[:row:row], s.Cells[row+1:]...)
+	tv.mu.Unlock()
+
+	if tv.OnRowDeleted != nil {
+		tv.OnRowDeleted(section, row)
+	}
+	tv.Refresh()
+}
+
+// endRowDrag drops a dragged cell among the visible cells of its section,
+// based on where its center ended up, and reports the move through OnRowMoved
+func (tv *Table) endRowDrag(cell *TableCell, section, from int) {
+	if ds := tv.currentDataSource(); ds != nil {
+		tv.endDataSourceRowDrag(ds, cell, section, from)
+		return
+	}
+
+	tv.mu.Lock()
+	if section >= len(tv.Sections) {
+		tv.mu.Unlock()
+		return
+	}
+	s := tv.Sections[section]
+	filter := tv.filter
+
+	center := cell.Position().Y + cell.Size().Height/2
+	others := make([]*TableCell, 0, len(s.Cells))
+	for _, c := range s.Cells {
+		if c != cell {
+			others = append(others, c)
+		}
+	}
+
+	// Insert before the first visible cell whose center is below the drop point
+	to := len(others)
+	for i, c := range others {
+		if filter != nil && !filter(c) {
+			continue
+		}
+		if c.Position().Y+c.Size().Height/2 > center {
+			to = i
+			break
+		}
+	}
+
+	cells := make([]*TableCell, 0, len(s.Cells))
+	cells = append(cells, others[:to]...)
+	cells = append(cells, cell)
+	cells = append(cells, others[to:]...)
+	s.Cells = cells
+	tv.mu.Unlock()
+
+	if to != from && tv.OnRowMoved != nil {
+		tv.OnRowMoved(section, from, to)
+	}
+	tv.Refresh()
+}
+
+// endDataSourceRowDrag reports where a dragged data source row was dropped,
+// based on the fixed row height, and reloads so the data source can reorder
+func (tv *Table) endDataSourceRowDrag(ds *tableDataSource, cell *TableCell, section, from int) {
+	center := cell.Position().Y + cell.Size().Height/2
+	sectionTop := tv.refreshReveal() + tv.dataSourceRowTop(ds, section, 0)
+
+	to := int((center - sectionTop) / tv.RowHeight)
+	if rows := ds.numRows(section); to >= rows {
+		to = rows - 1
+	}
+	if to < 0 {
+		to = 0
+	}
+
+	if to != from && tv.OnRowMoved != nil {
+		tv.OnRowMoved(section, from, to)
+	}
+	tv.ReloadData()
+}
+
+// dataSourceSectionTop returns the y of a data source section below the
+// refresh spinner, from the rows and headers of the sections before it
+func (tv *Table) dataSourceSectionTop(ds *tableDataSource, section int) float32 {
+	var y float32
+	for s := 0; s < section; s++ {
+		y += tv.dataSourceHeaderHeight(s) + float32(ds.numRows(s))*tv.RowHeight
+	}
+	return y
+}
+
+// dataSourceRowTop returns the y of a data source row below the refresh
+// spinner
+func (tv *Table) dataSourceRowTop(ds *tableDataSource, section, row int) float32 {
+	return tv.dataSourceSectionTop(ds, section) + tv.dataSourceHeaderHeight(section) + float32(row)*tv.RowHeight
+}
+
+// dataSourceTitle returns the title of a data source section, or "" when it
+// has no header
+func (tv *Table) dataSourceTitle(section int) string {
+	tv.mu.RLock()
+	titleForSection := tv.titleForSection
+	tv.mu.RUnlock()
+	if titleForSection == nil {
+		return ""
+	}
+	return titleForSection(section)
+}
+
+// dataSourceHeaderHeight returns the height of a data source section's
+// header, or 0 when it has none
+func (tv *Table) dataSourceHeaderHeight(section int) float32 {
+	if tv.dataSourceTitle(section) == "" {
+		return 0
+	}
+	return tv.sharedHeaderHeight()
+}
+
+// sharedHeaderHeight returns the height given to every data source section
+// header, measuring a header on first use
+func (tv *Table) sharedHeaderHeight() float32 {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+	if tv.headerHeight == 0 {
+		tv.headerHeight = NewTableHeaderView("Section").MinSize().Height
+	}
+	return tv.headerHeight
+}
+
+// SetFilter hides cells for which predicate returns false, and sections left
+// with no visible cells. Sections is not modified, so row indices reported by
+// OnCellSelected still refer to it. It does nothing for a table with a data
+// source, whose cells are only created as they scroll into view; filter the
+// data source's rows instead.
+func (tv *Table) SetFilter(predicate func(cell *TableCell) bool) {
+	tv.mu.Lock()
+	if tv.dataSource != nil {
+		tv.mu.Unlock()
+		return
+	}
+	tv.filter = predicate
+	tv.mu.Unlock()
+	tv.Refresh()
+}
+
+// ClearFilter shows all cells again
+func (tv *Table) ClearFilter() {
+	tv.SetFilter(nil)
+}
+
+// cellVisible reports whether the cell passes the current filter
+func (tv *Table) cellVisible(cell *TableCell) bool {
+	tv.mu.RLock()
+	filter := tv.filter
+	tv.mu.RUnlock()
+	return filter == nil || filter(cell)
+}
+
+// sectionVisible reports whether any of the section's cells pass the current
+// filter. Sections are always visible when no filter is set.
+func (tv *Table) sectionVisible(section *TableSection) bool {
+	tv.mu.RLock()
+	filter := tv.filter
+	tv.mu.RUnlock()
+	if filter == nil {
+		return true
+	}
+	for _, cell := range section.Cells {
+		if filter(cell) {
+			return true
+		}
+	}
+	return false
+}
+
+// FocusedRow returns the section and row keyboard navigation is on, and
+// whether there is one
+func (tv *Table) FocusedRow() (section, row int, ok bool) {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.focusPath.section, tv.focusPath.row, tv.hasFocusPath
+}
+
+// FocusGained implements fyne.Focusable, highlighting the first row when no
+// row was focused before
+func (tv *Table) FocusGained() {
+	first, ok := tv.edgeRow(true)
+	tv.mu.Lock()
+	tv.focused = true
+	if !tv.hasFocusPath && ok {
+		tv.focusPath = first
+		tv.hasFocusPath = true
+	}
+	tv.mu.Unlock()
+	tv.Refresh()
+}
+
+// FocusLost implements fyne.Focusable
+func (tv *Table) FocusLost() {
+	tv.mu.Lock()
+	tv.focused = false
+	tv.mu.Unlock()
+	tv.Refresh()
+}
+
+// TypedRune implements fyne.Focusable
+func (tv *Table) TypedRune(rune) {}
+
+// TypedKey implements fyne.Focusable. The up and down arrows move between
+// rows, Home and End jump to the first and last row, and Space or Return
+// taps the focused row, selecting it.
+func (tv *Table) TypedKey(key *fyne.KeyEvent) {
+	if core.IsActivationKey(key) {
+		tv.activateFocusedRow()
+		return
+	}
+
+	tv.mu.RLock()
+	current, hasCurrent := tv.focusPath, tv.hasFocusPath
+	tv.mu.RUnlock()
+
+	var next indexPath
+	var ok bool
+	switch key.Name {
+	case fyne.KeyUp, fyne.KeyDown:
+		if !hasCurrent {
+			next, ok = tv.edgeRow(true)
+		} else if key.Name == fyne.KeyUp {
+			next, ok = tv.adjacentRow(current, -1)
+		} else {
+			next, ok = tv.adjacentRow(current, 1)
+		}
+	case fyne.KeyHome:
+		next, ok = tv.edgeRow(true)
+	case fyne.KeyEnd:
+		next, ok = tv.edgeRow(false)
+	}
+	if !ok || (hasCurrent && next == current) {
+		return
+	}
+
+	tv.mu.Lock()
+	tv.focusPath = next
+	tv.hasFocusPath = true
+	tv.mu.Unlock()
+	tv.scrollToRow()
+	tv.Refresh()
+}
+
+// sectionCount returns the number of sections, from the data source if any
+func (tv *Table) sectionCount(ds *tableDataSource) int {
+	if ds != nil {
+		return ds.numSections()
+	}
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return len(tv.Sections)
+}
+
+// rowCount returns the number of rows in a section, visible or not
+func (tv *Table) rowCount(ds *tableDataSource, section int) int {
+	if ds != nil {
+		return ds.numRows(section)
+	}
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return len(tv.Sections[section].Cells)
+}
+
+// rowVisible returns whether a row passes the filter. Data source rows are
+// never filtered.
+func (tv *Table) rowVisible(ds *tableDataSource, path indexPath) bool {
+	if ds != nil {
+		return true
+	}
+	tv.mu.RLock()
+	cell := tv.Sections[path.section].Cells[path.row]
+	tv.mu.RUnlock()
+	return tv.cellVisible(cell)
+}
+
+// edgeRow returns the first visible row, or the last one, and whether the
+// table has any
+func (tv *Table) edgeRow(first bool) (indexPath, bool) {
+	ds := tv.currentDataSource()
+	sections := tv.sectionCount(ds)
+	if sections == 0 {
+		return indexPath{}, false
+	}
+	if first {
+		return tv.adjacentRow(indexPath{section: 0, row: -1}, 1)
+	}
+	return tv.adjacentRow(indexPath{section: sections - 1, row: tv.rowCount(ds, sections-1)}, -1)
+}
+
+// adjacentRow returns the visible row step rows before or after from,
+// skipping empty sections, and false when from is the first or last row.
+// Only the row counts are needed to step through a data source.
+func (tv *Table) adjacentRow(from indexPath, step int) (indexPath, bool) {
+	ds := tv.currentDataSource()
+	sections := tv.sectionCount(ds)
+	if from.section < 0 || from.section >= sections {
+		// The focused row's section was removed
+		return tv.edgeRow(step > 0)
+	}
+
+	path := from
+	for {
+		path.row += step
+		for path.row < 0 || path.row >= tv.rowCount(ds, path.section) {
+			path.section += step
+			if path.section < 0 || path.section >= sections {
+				return from, false
+			}
+			if step > 0 {
+				path.row = 0
+			} else {
+				path.row = tv.rowCount(ds, path.section) - 1
+			}
+		}
+		if tv.rowVisible(ds, path) {
+			return path, true
+		}
+	}
+}
+
+// focusedCell returns the cell of the focused row, or nil when it has not
+// been realized from the data source
+func (tv *Table) focusedCell() *TableCell {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	if !tv.hasFocusPath {
+		return nil
+	}
+	path := tv.focusPath
+	if tv.dataSource != nil {
+		return tv.realized[path]
+	}
+	if path.section < len(tv.Sections) && path.row < len(tv.Sections[path.section].Cells) {
+		return tv.Sections[path.section].Cells[path.row]
+	}
+	return nil
+}
+
+// activateFocusedRow taps the focused row's cell
+func (tv *Table) activateFocusedRow() {
+	if cell := tv.focusedCell(); cell != nil {
+		cell.Tapped(nil)
+		return
+	}
+
+	// A data source row that is not realized is selected directly
+	section, row, ok := tv.FocusedRow()
+	if ok && tv.currentDataSource() != nil && tv.AllowsSelection && !tv.IsEditing() && tv.OnCellSelected != nil {
+		tv.OnCellSelected(section, row)
+	}
+}
+
+// scrollToRow scrolls the table's scroll container, if any, just far enough
+// to show the focused row
+func (tv *Table) scrollToRow() {
+	tv.mu.RLock()
+	scroll := tv.scroll
+	tv.mu.RUnlock()
+	if scroll == nil {
+		return
+	}
+
+	var top, height float32
+	if ds := tv.currentDataSource(); ds != nil {
+		section, row, _ := tv.FocusedRow()
+		top = tv.refreshReveal() + tv.dataSourceRowTop(ds, section, row)
+		height = tv.RowHeight
+	} else if cell := tv.focusedCell(); cell != nil {
+		top = cell.Position().Y
+		height = cell.Size().Height
+	} else {
+		return
+	}
+
+	viewHeight := scroll.Size().Height
+	switch {
+	case top < scroll.Offset.Y:
+		scroll.ScrollToOffset(fyne.NewPos(scroll.Offset.X, top))
+	case top+height > scroll.Offset.Y+viewHeight:
+		scroll.ScrollToOffset(fyne.NewPos(scroll.Offset.X, top+height-viewHeight))
+	}
+}
+
+// pullsToRefresh returns whether drags over the table are taken to pull it
+// to refresh, which needs the table to own its scroll container
+func (tv *Table) pullsToRefresh() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.RefreshEnabled && tv.OnRefresh != nil && tv.scroll != nil
+}
+
+// pullDragged handles a drag over the table. Pulling down while scrolled to
+// the top reveals the refresh spinner; other drags scroll the table as usual.
+func (tv *Table) pullDragged(ev *fyne.DragEvent) {
+	tv.mu.Lock()
+	scroll := tv.scroll
+	atTop := scroll != nil && scroll.Offset.Y <= 0
+	pulling := tv.RefreshEnabled && tv.OnRefresh != nil && !tv.refreshing &&
+		atTop && (tv.pull > 0 || ev.Dragged.DY > 0)
+	if pulling {
+		tv.pull += ev.Dragged.DY
+		if tv.pull < 0 {
+			tv.pull = 0
+		}
+	}
+	pull := tv.pull
+	tv.mu.Unlock()
+
+	if !pulling {
+		if scroll != nil {
+			scroll.Dragged(ev)
+		}
+		return
+	}
+	tv.pullSpinner().SetProgress(float64(pull / refreshControlHeight))
+	tv.Refresh()
+}
+
+// pullDragEnd ends a drag over the table, starting a refresh if the table
+// was pulled far enough
+func (tv *Table) pullDragEnd() {
+	tv.mu.Lock()
+	pull := tv.pull
+	tv.pull = 0
+	scroll := tv.scroll
+	tv.mu.Unlock()
+
+	if pull >= refreshControlHeight {
+		tv.BeginRefreshing()
+		return
+	}
+	if pull > 0 {
+		tv.Refresh()
+	} else if scroll != nil {
+		scroll.DragEnd()
+	}
+}
+
+// BeginRefreshing shows the refresh spinner and calls OnRefresh
+func (tv *Table) BeginRefreshing() {
+	tv.mu.Lock()
+	if tv.refreshing {
+		tv.mu.Unlock()
+		return
+	}
+	tv.refreshing = true
+	onRefresh := tv.OnRefresh
+	tv.mu.Unlock()
+
+	tv.pullSpinner().SetIndeterminate(true)
+	tv.Refresh()
+
+	if onRefresh == nil {
+		return
+	}
+	var once sync.Once
+	onRefresh(func() {
+		once.Do(func() {
+			fyne.Do(tv.EndRefreshing)
+		})
+	})
+}
+
+// EndRefreshing hides the refresh spinner
+func (tv *Table) EndRefreshing() {
+	tv.mu.Lock()
+	if !tv.refreshing {
+		tv.mu.Unlock()
+		return
+	}
+	tv.refreshing = false
+	spinner := tv.spinner
+	tv.mu.Unlock()
+
+	spinner.SetIndeterminate(false)
+	tv.Refresh()
+}
+
+// IsRefreshing returns whether a refresh is in progress
+func (tv *Table) IsRefreshing() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.refreshing
+}
+
+// pullSpinner returns the refresh spinner, creating it on first use
+func (tv *Table) pullSpinner() *progress.RingProgress {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+	if tv.spinner == nil {
+		tv.spinner = progress.NewRingProgress()
+		tv.spinner.ViewSize = fyne.NewSize(28, 28)
+		tv.spinner.LineWidth = 3
+	}
+	return tv.spinner
+}
+
+// pullLayerWidget returns the layer that receives pull-to-refresh drags,
+// creating it on first use
+func (tv *Table) pullLayerWidget() *tablePullLayer {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+	if tv.pullLayer == nil {
+		tv.pullLayer = &tablePullLayer{table: tv}
+		tv.pullLayer.ExtendBaseWidget(tv.pullLayer)
+	}
+	return tv.pullLayer
+}
+
+// tablePullLayer receives the drags that pull a table to refresh. It sits
+// below the cells, which don't handle drags themselves, and is only shown
+// while pull-to-refresh is on, so other tables leave drags to their scroll.
+type tablePullLayer struct {
+	widget.BaseWidget
+	table *Table
+}
+
+// Dragged implements fyne.Draggable
+func (l *tablePullLayer) Dragged(ev *fyne.DragEvent) {
+	l.table.pullDragged(ev)
+}
+
+// DragEnd implements fyne.Draggable
+func (l *tablePullLayer) DragEnd() {
+	l.table.pullDragEnd()
+}
+
+func (l *tablePullLayer) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+// refreshReveal returns how far the cells are pushed down to show the spinner
+func (tv *Table) refreshReveal() float32 {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	if tv.refreshing {
+		return refreshControlHeight
+	}
+	return tv.pull
+}
+
+// NewScroll wraps the table in a vertical scroll container that the table
+// tracks, so the section index stays in the visible region and
+// ScrollToSection can move it
+func (tv *Table) NewScroll() *container.Scroll {
+	scroll := container.NewVScroll(tv)
+	scroll.OnScrolled = func(fyne.Position) {
+		if tv.currentDataSource() != nil || tv.pinsHeaders() {
+			// Realize the rows that scrolled into view, or move the pinned
+			// section header
+			tv.Refresh()
+		}
+		tv.layoutSectionIndex(tv.Size())
+	}
+
+	tv.mu.Lock()
+	tv.scroll = scroll
+	tv.mu.Unlock()
+	return scroll
+}
+
+// ScrollToSection scrolls the table's scroll container so the given section
+// is at the top. It does nothing unless the table was wrapped with NewScroll.
+func (tv *Table) ScrollToSection(section int) {
+	tv.mu.RLock()
+	scroll := tv.scroll
+	sections := tv.Sections
+	tv.mu.RUnlock()
+
+	if ds := tv.currentDataSource(); ds != nil {
+		if scroll != nil && section >= 0 && section < ds.numSections() {
+			scroll.ScrollToOffset(fyne.NewPos(0, tv.dataSourceSectionTop(ds, section)))
+		}
+		return
+	}
+
+	if scroll == nil || section < 0 || section >= len(sections) {
+		return
+	}
+
+	var y float32
+	for _, s := range sections[:section] {
+		if !tv.sectionVisible(s) {
+			continue
+		}
+		if header := s.headerView(); header != nil {
+			y += header.MinSize().Height
+		}
+		for _, cell := range s.Cells {
+			if tv.cellVisible(cell) {
+				y += cell.MinSize().Height
+			}
+		}
+		if footer := s.footerView(); footer != nil {
+			y += footer.MinSize().Height
+		}
+	}
+
+	scroll.ScrollToOffset(fyne.NewPos(0, y))
+	if tv.pinsHeaders() {
+		tv.Refresh()
+	}
+	tv.layoutSectionIndex(tv.Size())
+}
+
+// visibleRegion returns the top and height of the part of the table that is
+// currently on screen
+func (tv *Table) visibleRegion(size fyne.Size) (float32, float32) {
+	tv.mu.RLock()
+	scroll := tv.scroll
+	tv.mu.RUnlock()
+
+	if scroll == nil {
+		return 0, size.Height
+	}
+	height := scroll.Size().Height
+	if height > size.Height {
+		height = size.Height
+	}
+	return scroll.Offset.Y, height
+}
+
+// pinsHeaders returns whether section headers stick to the top of the
+// visible region
+func (tv *Table) pinsHeaders() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.StickyHeaders && tv.Style != TableStyleInsetGrouped && tv.scroll != nil
+}
+
+// layoutSectionIndex positions the index bar on the right edge, vertically
+// centered in the visible region
+func (tv *Table) layoutSectionIndex(size fyne.Size) {
+	tv.mu.RLock()
+	bar := tv.indexBar
+	tv.mu.RUnlock()
+
+	if bar == nil || !tv.ShowsSectionIndex {
+		return
+	}
+
+	top, height := tv.visibleRegion(size)
+	barSize := bar.MinSize()
+	if barSize.Height > height {
+		barSize.Height = height
+	}
+	bar.Resize(barSize)
+	bar.Move(fyne.NewPos(size.Width-barSize.Width, top+(height-barSize.Height)/2))
+}
+
+// updateSectionIndex syncs the index bar entries with the sections'
+// IndexTitle values
+func (tv *Table) updateSectionIndex(sections []*TableSection) {
+	if !tv.ShowsSectionIndex {
+		return
+	}
+
+	var titles []string
+	var targets []int
+	for i, section := range sections {
+		if section.IndexTitle != "" && tv.sectionVisible(section) {
+			titles = append(titles, section.IndexTitle)
+			targets = append(targets, i)
+		}
+	}
+
+	tv.mu.Lock()
+	if tv.indexBar == nil {
+		tv.indexBar = newSectionIndexBar(tv.ScrollToSection)
+	}
+	bar := tv.indexBar
+	tv.mu.Unlock()
+
+	bar.setEntries(titles, targets)
+}
+
+// CreateRenderer implements fyne.Widget
+func (tv *Table) CreateRenderer() fyne.WidgetRenderer {
+	tv.ExtendBaseWidget(tv)
+	return &tableViewRenderer{table: tv}
+}
+
+type tableViewRenderer struct {
+	table   *Table
+	objects []fyne.CanvasObject
+
+	// Data source mode: the y of each realized cell and the full content height
+	rowY          map[fyne.CanvasObject]float32
+	contentHeight float32
+
+	// empty is set when no cells are visible, so EmptyView shows
+	empty bool
+
+	// headers are the section headers with the index in objects of the last
+	// object of their section, for pinning sticky headers
+	headers []stickyHeader
+}
+
+// stickyHeader is a section header and where its section ends in the
+// renderer objects
+type stickyHeader struct {
+	header fyne.CanvasObject
+	last   int
+}
+
+func (r *tableViewRenderer) Destroy() {}
+
+func (r *tableViewRenderer) buildObjects() {
+	r.objects = nil
+
+	background := canvas.NewRectangle(r.table.BackgroundColor)
+	r.objects = append(r.objects, background)
+
+	r.rowY = nil
+	r.headers = nil
+	r.empty = true
+	if ds := r.table.currentDataSource(); ds != nil {
+		r.buildDataSourceObjects(ds)
+		return
+	}
+
+	r.table.mu.RLock()
+	sections := r.table.Sections
+	r.table.mu.RUnlock()
+
+	for si, section := range sections {
+		if !r.table.sectionVisible(section) {
+			continue
+		}
+		header := section.headerView()
+		if header != nil {
+			r.objects = append(r.objects, header)
+		}
+		lastRow := -1
+		for ri, cell := range section.Cells {
+			if r.table.cellVisible(cell) {
+				lastRow = ri
+			}
+		}
+		for ri, cell := range section.Cells {
+			if !r.table.cellVisible(cell) {
+				continue
+			}
+			r.table.wireCell(cell, si, ri, ri == lastRow)
+			r.objects = append(r.objects, cell)
+			r.empty = false
+		}
+		if footer := section.footerView(); footer != nil {
+			r.objects = append(r.objects, footer)
+		}
+		if header != nil {
+			r.headers = append(r.headers, stickyHeader{header: header, last: len(r.objects) - 1})
+		}
+	}
+
+	r.table.updateSectionIndex(sections)
+}
+
+// buildDataSourceObjects realizes the section headers and rows of the data
+// source that overlap the visible region, reusing those still in view. Only
+// the sections are walked; the visible rows of each are worked out from the
+// row height.
+func (r *tableViewRenderer) buildDataSourceObjects(ds *tableDataSource) {
+	tv := r.table
+	rowHeight := tv.RowHeight
+	top, height := tv.visibleRegion(tv.Size())
+	bottom := top + height
+	pins := tv.pinsHeaders()
+
+	tv.mu.RLock()
+	previous := tv.realized
+	previousHeaders := tv.realizedHeaders
+	tv.mu.RUnlock()
+
+	realized := make(map[indexPath]*TableCell)
+	headers := make(map[int]*TableHeaderFooterView)
+	r.rowY = make(map[fyne.CanvasObject]float32)
+	y := tv.refreshReveal()
+	total := 0
+	sections := ds.numSections()
+	for si := 0; si < sections; si++ {
+		rows := ds.numRows(si)
+		headerHeight := tv.dataSourceHeaderHeight(si)
+		sectionTop := y
+		rowsTop := sectionTop + headerHeight
+		y = rowsTop + float32(rows)*rowHeight
+		total += rows
+		if y <= top || sectionTop >= bottom {
+			continue
+		}
+
+		// A pinned header stays realized while its section is in view
+		var header *TableHeaderFooterView
+		if headerHeight > 0 && (rowsTop > top || pins) {
+			header = previousHeaders[si]
+			if header == nil {
+				header = NewTableHeaderView(tv.dataSourceTitle(si))
+			}
+			headers[si] = header
+			r.rowY[header] = sectionTop
+			r.objects = append(r.objects, header)
+		}
+
+		first := int((top - rowsTop) / rowHeight)
+		if first < 0 {
+			first = 0
+		}
+		last := int((bottom - rowsTop) / rowHeight)
+		if last > rows-1 {
+			last = rows - 1
+		}
+		for ri := first; ri <= last; ri++ {
+			path := indexPath{section: si, row: ri}
+			cell := previous[path]
+			if cell == nil {
+				cell = ds.cellForRow(si, ri)
+			}
+			if cell == nil {
+				continue
+			}
+			realized[path] = cell
+			tv.wireCell(cell, si, ri, ri == rows-1)
+			r.rowY[cell] = rowsTop + float32(ri)*rowHeight
+			r.objects = append(r.objects, cell)
+		}
+		if header != nil {
+			r.headers = append(r.headers, stickyHeader{header: header, last: len(r.objects) - 1})
+		}
+	}
+	r.contentHeight = y
+	r.empty = total == 0
+
+	tv.mu.Lock()
+	tv.realized = realized
+	tv.realizedHeaders = headers
+	tv.mu.Unlock()
+}
+
+func (r *tableViewRenderer) Layout(size fyne.Size) {
+	r.buildObjects()
+
+	if len(r.objects) == 0 {
 		return
 	}
 
 	r.objects[0].Resize(size)
+	if r.table.pullsToRefresh() {
+		r.table.pullLayerWidget().Resize(size)
+	}
 
-	y := float32(0)
+	y := r.table.refreshReveal()
 	inset := r.table.HorizontalInset
 
+	if y > 0 {
+		spinner := r.table.pullSpinner()
+		spinnerSize := spinner.MinSize()
+		spinner.Resize(spinnerSize)
+		spinner.Move(fyne.NewPos((size.Width-spinnerSize.Width)/2, (y-spinnerSize.Height)/2))
+	}
+
 	for i := 1; i < len(r.objects); i++ {
 		obj := r.objects[i]
+		if rowY, ok := r.rowY[obj]; ok {
+			height := r.table.RowHeight
+			if _, isHeader := obj.(*TableHeaderFooterView); isHeader {
+				height = r.table.sharedHeaderHeight()
+			}
+			obj.Resize(fyne.NewSize(size.Width-inset*2, height))
+			obj.Move(fyne.NewPos(inset, rowY))
+			continue
+		}
 		objSize := obj.MinSize()
 		obj.Resize(fyne.NewSize(size.Width-inset*2, objSize.Height))
 		obj.Move(fyne.NewPos(inset, y))
 		y += objSize.Height
 	}
+
+	r.layoutStickyHeaders(size)
+	r.table.layoutSectionIndex(size)
+	r.layoutEmptyView(size)
+}
+
+// layoutStickyHeaders moves each section header down to the top of the
+// visible region while its section is scrolled past, stopping where the
+// section ends so the next header pushes it up
+func (r *tableViewRenderer) layoutStickyHeaders(size fyne.Size) {
+	if !r.table.pinsHeaders() {
+		return
+	}
+
+	top, _ := r.table.visibleRegion(size)
+	for _, sh := range r.headers {
+		pos := sh.header.Position()
+		if pos.Y >= top {
+			continue
+		}
+		last := r.objects[sh.last]
+		sectionBottom := last.Position().Y + last.Size().Height
+		y := top
+		if limit := sectionBottom - sh.header.Size().Height; y > limit {
+			y = limit
+		}
+		if y > pos.Y {
+			sh.header.Move(fyne.NewPos(pos.X, y))
+		}
+	}
+}
+
+// stickyObjects returns the objects with the section headers last, so a
+// pinned header draws above the cells scrolling under it
+func (r *tableViewRenderer) stickyObjects() []fyne.CanvasObject {
+	headers := make(map[fyne.CanvasObject]bool, len(r.headers))
+	for _, sh := range r.headers {
+		headers[sh.header] = true
+	}
+
+	objects := make([]fyne.CanvasObject, 0, len(r.objects))
+	for _, obj := range r.objects {
+		if !headers[obj] {
+			objects = append(objects, obj)
+		}
+	}
+	for _, sh := range r.headers {
+		objects = append(objects, sh.header)
+	}
+	return objects
+}
+
+// emptyView returns the EmptyView if it should be shown
+func (r *tableViewRenderer) emptyView() fyne.CanvasObject {
+	r.table.mu.RLock()
+	defer r.table.mu.RUnlock()
+	if !r.empty {
+		return nil
+	}
+	return r.table.EmptyView
+}
+
+// layoutEmptyView centers the EmptyView in the visible region below any
+// headers and the refresh spinner
+func (r *tableViewRenderer) layoutEmptyView(size fyne.Size) {
+	view := r.emptyView()
+	if view == nil {
+		return
+	}
+
+	var contentBottom float32
+	for _, obj := range r.objects[1:] {
+		if bottom := obj.Position().Y + obj.Size().Height; bottom > contentBottom {
+			contentBottom = bottom
+		}
+	}
+	if reveal := r.table.refreshReveal(); reveal > contentBottom {
+		contentBottom = reveal
+	}
+
+	top, height := r.table.visibleRegion(size)
+	if top < contentBottom {
+		height -= contentBottom - top
+		top = contentBottom
+	}
+	viewSize := view.MinSize()
+	view.Resize(viewSize)
+	view.Move(fyne.NewPos((size.Width-viewSize.Width)/2, top+(height-viewSize.Height)/2))
 }
 
 func (r *tableViewRenderer) MinSize() fyne.Size {
 	r.buildObjects()
-
-	var height float32
-	for i := 1; i < len(r.objects); i++ {
-		height += r.objects[i].MinSize().Height
+	height := r.table.refreshReveal()
+	if r.rowY != nil {
+		height = r.contentHeight
+	} else {
+		for i := 1; i < len(r.objects); i++ {
+			height += r.objects[i].MinSize().Height
+		}
+	}
+	if view := r.emptyView(); view != nil {
+		height += view.MinSize().Height
 	}
 
 	return fyne.NewSize(200, height)
@@ -549,6 +2283,7 @@ func (r *tableViewRenderer) MinSize() fyne.Size {
 
 func (r *tableViewRenderer) Refresh() {
 	r.buildObjects()
+	r.Layout(r.table.Size())
 	for _, obj := range r.objects {
 		obj.Refresh()
 	}
@@ -556,7 +2291,217 @@ func (r *tableViewRenderer) Refresh() {
 
 func (r *tableViewRenderer) Objects() []fyne.CanvasObject {
 	r.buildObjects()
-	return r.objects
+
+	r.table.mu.RLock()
+	bar := r.table.indexBar
+	spinner := r.table.spinner
+	r.table.mu.RUnlock()
+
+	base := r.objects
+	if len(r.headers) > 0 && r.table.pinsHeaders() {
+		base = r.stickyObjects()
+	}
+
+	showsSpinner := spinner != nil && r.table.refreshReveal() > 0
+	showsBar := bar != nil && r.table.ShowsSectionIndex
+	pulls := r.table.pullsToRefresh()
+	emptyView := r.emptyView()
+	if !showsSpinner && !showsBar && !pulls && emptyView == nil {
+		return base
+	}
+
+	objects := make([]fyne.CanvasObject, 0, len(base)+4)
+	if pulls {
+		// The pull layer sits just above the background, below the cells
+		objects = append(objects, base[0], r.table.pullLayerWidget())
+		objects = append(objects, base[1:]...)
+	} else {
+		objects = append(objects, base...)
+	}
+	if emptyView != nil {
+		objects = append(objects, emptyView)
+	}
+	if showsSpinner {
+		objects = append(objects, spinner)
+	}
+	if showsBar {
+		objects = append(objects, bar)
+	}
+	return objects
+}
+
+// sectionIndexBar is the vertical A-Z strip drawn on the right edge of a
+// table. Tapping or dragging over an entry jumps to its section.
+type sectionIndexBar struct {
+	widget.BaseWidget
+
+	onSelect func(section int)
+
+	mu       sync.RWMutex
+	titles   []string
+	targets  []int
+	tracking bool
+	current  int
+}
+
+func newSectionIndexBar(onSelect func(section int)) *sectionIndexBar {
+	b := &sectionIndexBar{onSelect: onSelect, current: -1}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+func (b *sectionIndexBar) setEntries(titles []string, targets []int) {
+	b.mu.Lock()
+	b.titles = titles
+	b.targets = targets
+	b.mu.Unlock()
+}
+
+// selectAt jumps to the section whose entry is under the y position
+func (b *sectionIndexBar) selectAt(y float32) {
+	b.mu.Lock()
+	count := len(b.targets)
+	if count == 0 {
+		b.mu.Unlock()
+		return
+	}
+	index := int(y / (b.Size().Height / float32(count)))
+	if index < 0 {
+		index = 0
+	}
+	if index >= count {
+		index = count - 1
+	}
+	changed := index != b.current
+	b.current = index
+	target := b.targets[index]
+	b.mu.Unlock()
+
+	if changed && b.onSelect != nil {
+		b.onSelect(target)
+	}
+}
+
+// Tapped handles tap events
+func (b *sectionIndexBar) Tapped(ev *fyne.PointEvent) {
+	b.mu.Lock()
+	b.current = -1
+	b.mu.Unlock()
+	b.selectAt(ev.Position.Y)
+}
+
+// Dragged handles drag events, tracking the entry under the pointer
+func (b *sectionIndexBar) Dragged(ev *fyne.DragEvent) {
+	b.mu.Lock()
+	started := !b.tracking
+	b.tracking = true
+	b.mu.Unlock()
+	if started {
+		b.Refresh()
+	}
+	b.selectAt(ev.Position.Y)
+}
+
+// DragEnd handles the end of a drag
+func (b *sectionIndexBar) DragEnd() {
+	b.mu.Lock()
+	b.tracking = false
+	b.current = -1
+	b.mu.Unlock()
+	b.Refresh()
+}
+
+func (b *sectionIndexBar) CreateRenderer() fyne.WidgetRenderer {
+	config := core.SharedConfiguration()
+	return &sectionIndexBarRenderer{
+		bar:        b,
+		background: canvas.NewRectangle(config.TableSectionIndexBackgroundColor),
+	}
+}
+
+type sectionIndexBarRenderer struct {
+	bar        *sectionIndexBar
+	background *canvas.Rectangle
+	labels     []*canvas.Text
+}
+
+const (
+	sectionIndexWidth     float32 = 20
+	sectionIndexRowHeight float32 = 15
+	sectionIndexFontSize  float32 = 11
+)
+
+func (r *sectionIndexBarRenderer) Destroy() {}
+
+func (r *sectionIndexBarRenderer) syncLabels() {
+	r.bar.mu.RLock()
+	titles := r.bar.titles
+	r.bar.mu.RUnlock()
+
+	config := core.SharedConfiguration()
+	for len(r.labels) < len(titles) {
+		text := canvas.NewText("", config.TableSectionIndexColor)
+		text.TextSize = sectionIndexFontSize
+		text.TextStyle = fyne.TextStyle{Bold: true}
+		text.Alignment = fyne.TextAlignCenter
+		r.labels = append(r.labels, text)
+	}
+	r.labels = r.labels[:len(titles)]
+	for i, title := range titles {
+		r.labels[i].Text = title
+		r.labels[i].Color = config.TableSectionIndexColor
+	}
+}
+
+func (r *sectionIndexBarRenderer) Layout(size fyne.Size) {
+	r.syncLabels()
+	r.background.Resize(size)
+	r.background.CornerRadius = size.Width / 2
+
+	if len(r.labels) == 0 {
+		return
+	}
+	rowHeight := size.Height / float32(len(r.labels))
+	for i, label := range r.labels {
+		textHeight := label.MinSize().Height
+		label.Resize(fyne.NewSize(size.Width, textHeight))
+		label.Move(fyne.NewPos(0, rowHeight*float32(i)+(rowHeight-textHeight)/2))
+	}
+}
+
+func (r *sectionIndexBarRenderer) MinSize() fyne.Size {
+	r.bar.mu.RLock()
+	count := len(r.bar.titles)
+	r.bar.mu.RUnlock()
+	return fyne.NewSize(sectionIndexWidth, sectionIndexRowHeight*float32(count))
+}
+
+func (r *sectionIndexBarRenderer) Refresh() {
+	r.bar.mu.RLock()
+	tracking := r.bar.tracking
+	r.bar.mu.RUnlock()
+
+	config := core.SharedConfiguration()
+	if tracking {
+		r.background.FillColor = config.TableSectionIndexTrackingBackgroundColor
+	} else {
+		r.background.FillColor = config.TableSectionIndexBackgroundColor
+	}
+	r.background.Refresh()
+
+	r.Layout(r.bar.Size())
+	for _, label := range r.labels {
+		label.Refresh()
+	}
+}
+
+func (r *sectionIndexBarRenderer) Objects() []fyne.CanvasObject {
+	r.syncLabels()
+	objects := []fyne.CanvasObject{r.background}
+	for _, label := range r.labels {
+		objects = append(objects, label)
+	}
+	return objects
 }
 
 // StaticTableCellData represents static cell data
@@ -3,7 +3,9 @@
 package tests
 
 import (
+	"bytes"
 	"image/color"
+	"strings"
 	"testing"
 	"time"
 
@@ -545,6 +547,91 @@ func TestPagingLayout(t *testing.T) {
 	}
 }
 
+func TestPagingLayout_PageBuilder(t *testing.T) {
+	setupTest()
+
+	built := make(map[int]int)
+	pl := collection.NewPagingLayout()
+	pl.SetPageBuilder(100, func(index int) fyne.CanvasObject {
+		built[index]++
+		return widget.NewLabel("Page")
+	})
+	testWindow.SetContent(pl)
+
+	if pl.GetPageCount() != 100 {
+		t.Errorf("Expected 100 pages, got %d", pl.GetPageCount())
+	}
+	if len(built) == 0 || len(built) > 5 {
+		t.Errorf("Only the pages near the viewport should be built, got %d", len(built))
+	}
+	if built[99] != 0 {
+		t.Error("Far pages should not be built")
+	}
+
+	pl.SetCurrentPage(50)
+	if built[50] != 1 {
+		t.Errorf("Page 50 should be built once it is near the viewport, built %d times", built[50])
+	}
+	// The background and page indicators are the other objects
+	loaded := len(test.WidgetRenderer(pl).Objects()) - 1 - pl.GetPageCount()
+	if loaded > 5 {
+		t.Errorf("Pages far from the viewport should be released, have %d objects", loaded)
+	}
+
+	pl.SetCurrentPage(0)
+	if built[0] != 2 {
+		t.Errorf("A released page should be rebuilt when it comes back, built %d times", built[0])
+	}
+
+	// The eager API switches back from the builder
+	pl.SetItems([]fyne.CanvasObject{widget.NewLabel("A"), widget.NewLabel("B")})
+	if pl.GetPageCount() != 2 {
+		t.Errorf("SetItems should replace the page builder, got %d pages", pl.GetPageCount())
+	}
+}
+
+func TestPagingLayout_OnPageSettled(t *testing.T) {
+	setupTest()
+
+	pl := collection.NewPagingLayout()
+	pl.AnimationDuration = time.Millisecond * 100
+	for i := 0; i < 4; i++ {
+		pl.AddPage(widget.NewLabel("Page"))
+	}
+	settled := make(chan int, 4)
+	pl.OnPageSettled = func(page int) {
+		settled <- page
+	}
+	testWindow.SetContent(pl)
+	testWindow.Resize(fyne.NewSize(300, 200))
+
+	pl.GoToPage(1)
+	if !pl.IsSettling() {
+		t.Error("Layout should be settling while it animates to the page")
+	}
+	// A second page change before the first settles supersedes it
+	pl.GoToPage(2)
+	if len(settled) != 0 {
+		t.Fatal("OnPageSettled should not be called before the animation finishes")
+	}
+
+	select {
+	case page := <-settled:
+		if page != 2 {
+			t.Errorf("Expected page 2 to settle, got %d", page)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("OnPageSettled should be called once the animation finishes")
+	}
+	// The superseded change started first, so it would have settled first
+	if len(settled) != 0 {
+		t.Error("The superseded page change should not settle")
+	}
+	if pl.IsSettling() {
+		t.Error("Layout should not be settling after the animation finishes")
+	}
+}
+
 // ============ Theme Tests ============
 
 func TestThemeManager(t *testing.T) {
@@ -580,6 +667,45 @@ func TestThemeChangeListener(t *testing.T) {
 	tm.SetCurrentTheme(theme.ThemeIdentifierDefault)
 }
 
+func TestThemeSwitchUpdatesAlertAndToastColors(t *testing.T) {
+	setupTest()
+	tm := theme.SharedThemeManager()
+	tm.SetCurrentTheme(theme.ThemeIdentifierDefault)
+	defer core.ResetConfigurationForTesting()
+	defer tm.SetCurrentTheme(theme.ThemeIdentifierDefault)
+
+	// Created before the switch, shown after it
+	a := alert.NewAlert("Title", "Message", alert.ControllerStyleAlert)
+	custom := color.RGBA{R: 200, A: 255}
+	a.AlertMessageColor = custom
+	tv := toast.NewToastViewWithText("Saved")
+	tv.Duration = 0
+
+	tm.SetCurrentTheme(theme.ThemeIdentifierDark)
+	dark := tm.CurrentTheme()
+
+	a.ShowIn(testWindow)
+	defer a.Hide()
+	if a.AlertTitleColor != dark.TextPrimaryColor {
+		t.Errorf("Alert title should use the dark theme text color, got %v", a.AlertTitleColor)
+	}
+	if a.AlertHeaderBackgroundColor != dark.SurfaceColor {
+		t.Errorf("Alert surface should use the dark theme surface color, got %v", a.AlertHeaderBackgroundColor)
+	}
+	if a.AlertMessageColor != custom {
+		t.Error("Customized alert colors should survive a theme switch")
+	}
+
+	tv.ShowIn(testWindow)
+	defer tv.Hide()
+	if tv.TextColor != dark.BackgroundColor {
+		t.Errorf("Toast text should contrast with the dark toast background, got %v", tv.TextColor)
+	}
+	if tv.BackgroundColor != core.SharedConfiguration().ToastBackgroundColor {
+		t.Error("Toast background should follow the configuration after a theme switch")
+	}
+}
+
 // ============ Configuration Tests ============
 
 func TestConfiguration(t *testing.T) {
@@ -597,6 +723,103 @@ func TestConfiguration(t *testing.T) {
 	}
 }
 
+func TestConfigurationJSONRoundTrip(t *testing.T) {
+	cfg := core.SharedConfiguration()
+
+	var buf bytes.Buffer
+	if err := cfg.SaveJSON(&buf); err != nil {
+		t.Fatalf("SaveJSON failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"RedColor": "#FA3A3AFF"`) {
+		t.Error("Colors should be saved as hex strings")
+	}
+
+	loaded := &core.Configuration{}
+	if err := loaded.LoadJSON(&buf); err != nil {
+		t.Fatalf("LoadJSON failed: %v", err)
+	}
+	if core.ColorToHex(loaded.BlueColor) != core.ColorToHex(cfg.BlueColor) {
+		t.Error("BlueColor should survive a round trip")
+	}
+	if loaded.TextFieldTextInsets != cfg.TextFieldTextInsets {
+		t.Error("EdgeInsets should survive a round trip")
+	}
+	if loaded.ToastDefaultDuration != cfg.ToastDefaultDuration {
+		t.Error("Numbers should survive a round trip")
+	}
+}
+
+func TestConfigurationLoadJSONKeepsDefaults(t *testing.T) {
+	cfg := &core.Configuration{ToastFontSize: 16, GreenColor: color.White}
+
+	err := cfg.LoadJSON(strings.NewReader(`{"ToastFontSize": 18, "RedColor": "#FF0000", "NotAField": 1}`))
+	if err != nil {
+		t.Fatalf("LoadJSON failed: %v", err)
+	}
+	if cfg.ToastFontSize != 18 {
+		t.Errorf("ToastFontSize should load as 18, got %v", cfg.ToastFontSize)
+	}
+	if core.ColorToHex(cfg.RedColor) != "#FF0000FF" {
+		t.Errorf("RedColor should load from hex, got %s", core.ColorToHex(cfg.RedColor))
+	}
+	if cfg.GreenColor != color.White {
+		t.Error("Missing fields should keep their values")
+	}
+
+	if err := cfg.LoadJSON(strings.NewReader(`{"WhiteColor": "#000000", "BlueColor": "blue"}`)); err == nil {
+		t.Error("Invalid hex colors should be reported")
+	}
+	if cfg.WhiteColor != nil {
+		t.Error("A failed load should leave the configuration unchanged")
+	}
+
+	notified := 0
+	cfg.AddChangeListener(func(*core.Configuration) {
+		notified++
+	})
+	if err := cfg.LoadJSON(strings.NewReader(`{"ToastFontSize": 20}`)); err != nil {
+		t.Fatalf("LoadJSON failed: %v", err)
+	}
+	if notified != 1 {
+		t.Errorf("LoadJSON should notify the change listeners once, notified %d", notified)
+	}
+}
+
+// brandTemplate is a configuration template used by the template tests
+type brandTemplate struct {
+	automatic bool
+}
+
+func (b *brandTemplate) ApplyConfigurationTemplate() {
+	core.SharedConfiguration().BlueColor = color.RGBA{R: 255, G: 102, B: 0, A: 255}
+}
+
+func (b *brandTemplate) ShouldApplyTemplateAutomatically() bool {
+	return b.automatic
+}
+
+func TestConfigurationTemplates(t *testing.T) {
+	core.ResetConfigurationForTesting()
+	defer core.ResetConfigurationForTesting()
+
+	core.RegisterConfigurationTemplate(&brandTemplate{automatic: true})
+	if core.ColorToHex(core.SharedConfiguration().BlueColor) != "#FF6600FF" {
+		t.Error("Automatic templates should be applied on startup")
+	}
+
+	core.ResetConfigurationForTesting()
+	manual := &brandTemplate{automatic: false}
+	core.RegisterConfigurationTemplate(manual)
+	if core.ColorToHex(core.SharedConfiguration().BlueColor) == "#FF6600FF" {
+		t.Error("Manual templates should not be applied on startup")
+	}
+
+	core.ApplyTemplate(manual)
+	if core.ColorToHex(core.SharedConfiguration().BlueColor) != "#FF6600FF" {
+		t.Error("ApplyTemplate should apply the template")
+	}
+}
+
 // ============ Comprehensive Widget Cycle Test ============
 
 func TestAllWidgetsCycle(t *testing.T) {
@@ -3,17 +3,45 @@
 package textfield
 
 import (
+	"fmt"
 	"image/color"
+	"strconv"
 	"sync"
+	"unicode"
 	"unicode/utf8"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
 )
 
+// ClearButtonMode determines when the clear button is shown
+type ClearButtonMode int
+
+const (
+	// ClearButtonNever never shows the clear button
+	ClearButtonNever ClearButtonMode = iota
+	// ClearButtonWhileEditing shows the clear button while the field has focus and text
+	ClearButtonWhileEditing
+	// ClearButtonAlways shows the clear button whenever the field has text
+	ClearButtonAlways
+)
+
+const (
+	clearButtonSize   = 16
+	revealButtonWidth = 20
+	maskDigit         = '#'
+
+	characterCountTextSize = 12
+	errorMessagePadding    = 2
+
+	// disabledBackgroundAlpha is the alpha of DisabledColor behind a disabled field
+	disabledBackgroundAlpha = 0.2
+)
+
 // TextFieldDelegate provides callbacks for text field events
 type TextFieldDelegate interface {
 	// ShouldChangeCharactersInRange is called before text changes
@@ -30,20 +58,36 @@ type TextField struct {
 	PlaceholderColor color.Color
 	TextInsets       core.EdgeInsets
 	ClearButtonPositionAdjustment core.Offset
+	ClearButtonMode               ClearButtonMode
+	ErrorBorderColor              color.Color
 
 	// Behavior
+	Secure                                      bool // Masks input with bullets
+	SecureEntryRevealable                       bool // Shows an eye toggle to reveal secure text
 	ShouldResponseToProgrammaticallyTextChanges bool
 	MaximumTextLength                           int
 	ShouldCountingNonASCIICharacterAsTwo        bool
+	InputMask                                   string // "#" accepts a digit, other characters are literal separators
+	ShowsCharacterCount                         bool   // Shows a "12/50" counter at the bottom-right
+	ShowsValidationMessage                      bool   // Shows the validation error below the field
+	Enabled                                     bool   // When false the field is dimmed and ignores input; set with SetEnabled
+
+	// Validator is evaluated on each change; a non-nil error marks the field invalid.
+	// It is independent of widget.Entry's own Validator.
+	Validator func(text string) error
 
 	// Delegate
 	Delegate TextFieldDelegate
 
 	// Callbacks
-	OnTextChanged func(text string)
-	OnPaste       func(sender interface{}) bool
-
-	mu sync.RWMutex
+	OnTextChanged      func(text string)
+	OnPaste            func(sender interface{}) bool
+	OnReachedMaxLength func()
+
+	mu       sync.RWMutex
+	focused  bool
+	revealed bool
+	validationErr error
 }
 
 // NewTextField creates a new QMUI-styled text field
@@ -52,9 +96,11 @@ func NewTextField() *TextField {
 	tf := &TextField{
 		PlaceholderColor: config.PlaceholderColor,
 		TextInsets:       config.TextFieldTextInsets,
+		ErrorBorderColor: config.RedColor,
 		ShouldResponseToProgrammaticallyTextChanges: true,
 		MaximumTextLength: -1, // No limit
 		ShouldCountingNonASCIICharacterAsTwo: false,
+		Enabled:           true,
 	}
 	tf.ExtendBaseWidget(tf)
 	tf.Entry.OnChanged = tf.handleTextChanged
@@ -76,19 +122,74 @@ func (tf *TextField) SetText(text string) {
 
 	tf.Entry.SetText(text)
 	if shouldNotify && tf.OnTextChanged != nil {
-		tf.OnTextChanged(text)
+		tf.OnTextChanged(tf.Text)
 	}
 }
 
+// SetEnabled sets whether the field accepts input
+func (tf *TextField) SetEnabled(enabled bool) {
+	tf.mu.Lock()
+	tf.Enabled = enabled
+	tf.mu.Unlock()
+
+	// The entry blocks typing, pasting and focus while disabled
+	if enabled {
+		tf.Entry.Enable()
+	} else {
+		tf.Entry.Disable()
+	}
+	tf.Refresh()
+}
+
+// IsEnabled returns whether the field accepts input
+func (tf *TextField) IsEnabled() bool {
+	tf.mu.RLock()
+	defer tf.mu.RUnlock()
+	return tf.Enabled
+}
+
+// Enable enables the field, implementing fyne.Disableable
+func (tf *TextField) Enable() {
+	tf.SetEnabled(true)
+}
+
+// Disable disables the field, implementing fyne.Disableable
+func (tf *TextField) Disable() {
+	tf.SetEnabled(false)
+}
+
+// Disabled returns whether the field is disabled, so focus traversal skips it
+func (tf *TextField) Disabled() bool {
+	return !tf.IsEnabled()
+}
+
 // handleTextChanged processes text changes with length limiting
 func (tf *TextField) handleTextChanged(text string) {
 	tf.mu.RLock()
 	maxLen := tf.MaximumTextLength
 	countNonASCII := tf.ShouldCountingNonASCIICharacterAsTwo
+	mask := tf.InputMask
 	tf.mu.RUnlock()
 
-	if maxLen > 0 {
+	if mask != "" {
+		raw := rawTextForMask(mask, text)
+		formatted := applyInputMask(mask, raw, maxLen)
+		if formatted != text {
+			// Re-enters handleTextChanged with the formatted text, which notifies
+			tf.Entry.SetText(formatted)
+			tf.CursorColumn = utf8.RuneCountInString(formatted)
+			tf.Entry.Refresh()
+			return
+		}
+		if maxLen > 0 && len(raw) == maxLen && tf.OnReachedMaxLength != nil {
+			defer tf.OnReachedMaxLength()
+		}
+	} else if maxLen > 0 {
 		length := tf.calculateTextLength(text, countNonASCII)
+		// Trimmed text re-enters here, so reaching the limit is reported once
+		if length == maxLen && tf.OnReachedMaxLength != nil {
+			defer tf.OnReachedMaxLength()
+		}
 		if length > maxLen {
 			// Trim text to max length
 			trimmed := tf.trimToLength(text, maxLen, countNonASCII)
@@ -100,11 +201,202 @@ func (tf *TextField) handleTextChanged(text string) {
 		}
 	}
 
+	tf.validate(text)
+
 	if tf.OnTextChanged != nil {
 		tf.OnTextChanged(text)
 	}
 }
 
+// validate runs Validator against text and records the result for styling;
+// the entry refreshes once the change callback returns
+func (tf *TextField) validate(text string) {
+	var err error
+	if tf.Validator != nil {
+		err = tf.Validator(text)
+	}
+	tf.mu.Lock()
+	tf.validationErr = err
+	tf.mu.Unlock()
+}
+
+// IsValid returns whether the current text passes Validator
+func (tf *TextField) IsValid() bool {
+	return tf.Validator == nil || tf.Validator(tf.Text) == nil
+}
+
+// ValidationError returns the error from the last validation, if any
+func (tf *TextField) ValidationError() error {
+	tf.mu.RLock()
+	defer tf.mu.RUnlock()
+	return tf.validationErr
+}
+
+// TypedKey handles key events, skipping mask literals on backspace. Escape
+// dismisses the popup the field is shown in, if any.
+func (tf *TextField) TypedKey(key *fyne.KeyEvent) {
+	if key.Name == fyne.KeyEscape && core.SharedOverlayManager().DismissTopFor(tf) {
+		return
+	}
+
+	tf.mu.RLock()
+	mask := []rune(tf.InputMask)
+	tf.mu.RUnlock()
+
+	if key.Name == fyne.KeyBackspace && len(mask) > 0 && !tf.MultiLine {
+		for tf.CursorColumn > 0 && tf.CursorColumn <= len(mask) && mask[tf.CursorColumn-1] != maskDigit {
+			tf.CursorColumn--
+		}
+	}
+	tf.Entry.TypedKey(key)
+}
+
+// RawText returns the text without the literal separators of InputMask
+func (tf *TextField) RawText() string {
+	tf.mu.RLock()
+	mask := tf.InputMask
+	tf.mu.RUnlock()
+
+	if mask == "" {
+		return tf.Text
+	}
+	return string(rawTextForMask(mask, tf.Text))
+}
+
+// CharacterCount returns the length of the text as counted against MaximumTextLength
+func (tf *TextField) CharacterCount() int {
+	tf.mu.RLock()
+	mask := tf.InputMask
+	countNonASCII := tf.ShouldCountingNonASCIICharacterAsTwo
+	tf.mu.RUnlock()
+
+	if mask != "" {
+		return len(rawTextForMask(mask, tf.Text))
+	}
+	return tf.calculateTextLength(tf.Text, countNonASCII)
+}
+
+// characterCountText returns the counter label and whether the limit is reached
+func (tf *TextField) characterCountText() (string, bool) {
+	count := tf.CharacterCount()
+	if tf.MaximumTextLength > 0 {
+		return fmt.Sprintf("%d/%d", count, tf.MaximumTextLength), count >= tf.MaximumTextLength
+	}
+	return strconv.Itoa(count), false
+}
+
+// FocusGained handles focus gained events
+func (tf *TextField) FocusGained() {
+	tf.mu.Lock()
+	tf.focused = true
+	tf.mu.Unlock()
+	tf.Entry.FocusGained()
+	core.NotifyFocusChanged(tf, true)
+}
+
+// FocusLost handles focus lost events
+func (tf *TextField) FocusLost() {
+	tf.mu.Lock()
+	tf.focused = false
+	tf.mu.Unlock()
+	tf.Entry.FocusLost()
+	core.NotifyFocusChanged(tf, false)
+}
+
+// Clear empties the field, firing the change callbacks
+func (tf *TextField) Clear() {
+	tf.Entry.SetText("")
+}
+
+// SetSecureTextRevealed shows or masks the text of a secure field
+func (tf *TextField) SetSecureTextRevealed(revealed bool) {
+	tf.mu.Lock()
+	tf.revealed = revealed
+	tf.mu.Unlock()
+	tf.Refresh()
+}
+
+// IsSecureTextRevealed returns whether the text of a secure field is shown in plain text
+func (tf *TextField) IsSecureTextRevealed() bool {
+	tf.mu.RLock()
+	defer tf.mu.RUnlock()
+	return tf.revealed
+}
+
+// applySecureState syncs the entry's masking with Secure and the reveal toggle
+func (tf *TextField) applySecureState() {
+	if !tf.Secure {
+		return
+	}
+	tf.mu.RLock()
+	revealed := tf.revealed && tf.SecureEntryRevealable
+	tf.mu.RUnlock()
+	tf.Password = !revealed
+}
+
+// showsClearButton returns whether the clear button should currently be visible
+func (tf *TextField) showsClearButton() bool {
+	tf.mu.RLock()
+	mode := tf.ClearButtonMode
+	focused := tf.focused
+	enabled := tf.Enabled
+	tf.mu.RUnlock()
+
+	if tf.Text == "" || !enabled {
+		return false
+	}
+	switch mode {
+	case ClearButtonAlways:
+		return true
+	case ClearButtonWhileEditing:
+		return focused
+	}
+	return false
+}
+
+// rawTextForMask extracts the digits entered into the slots of mask
+func rawTextForMask(mask, text string) []rune {
+	maskRunes := []rune(mask)
+	var raw []rune
+	mi := 0
+	for _, r := range text {
+		if mi < len(maskRunes) && maskRunes[mi] != maskDigit && r == maskRunes[mi] {
+			mi++
+			continue
+		}
+		if !unicode.IsDigit(r) {
+			continue
+		}
+		raw = append(raw, r)
+		for mi < len(maskRunes) && maskRunes[mi] != maskDigit {
+			mi++
+		}
+		mi++
+	}
+	return raw
+}
+
+// applyInputMask formats raw digits with mask, limited to maxLen raw characters
+func applyInputMask(mask string, raw []rune, maxLen int) string {
+	if maxLen > 0 && len(raw) > maxLen {
+		raw = raw[:maxLen]
+	}
+	var out []rune
+	ri := 0
+	for _, m := range mask {
+		if ri >= len(raw) {
+			break
+		}
+		if m == maskDigit {
+			out = append(out, raw[ri])
+			ri++
+		} else {
+			out = append(out, m)
+		}
+	}
+	return string(out)
+}
+
 func (tf *TextField) calculateTextLength(s string, countNonASCIIAsTwo bool) int {
 	if !countNonASCIIAsTwo {
 		return utf8.RuneCountInString(s)
@@ -155,13 +447,30 @@ func (tf *TextField) CreateRenderer() fyne.WidgetRenderer {
 	border.StrokeWidth = 1
 	border.StrokeColor = core.SharedConfiguration().SeparatorColor
 
+	// Masking is applied after the entry renderer exists so that Fyne doesn't
+	// add its own password revealer in place of ours
+	if tf.Secure {
+		tf.Password = false
+	}
 	entryRenderer := tf.Entry.CreateRenderer()
+	tf.applySecureState()
+
+	counter := canvas.NewText("", tf.PlaceholderColor)
+	counter.TextSize = characterCountTextSize
+	counter.Alignment = fyne.TextAlignTrailing
+
+	errorMessage := canvas.NewText("", tf.ErrorBorderColor)
+	errorMessage.TextSize = characterCountTextSize
 
 	return &textFieldRenderer{
 		textField:     tf,
 		background:    background,
 		border:        border,
 		entryRenderer: entryRenderer,
+		clearButton:   newClearButton(tf),
+		revealButton:  newRevealButton(tf),
+		counter:       counter,
+		errorMessage:  errorMessage,
 	}
 }
 
@@ -170,6 +479,10 @@ type textFieldRenderer struct {
 	background    *canvas.Rectangle
 	border        *canvas.Rectangle
 	entryRenderer fyne.WidgetRenderer
+	clearButton   *clearButton
+	revealButton  *revealButton
+	counter       *canvas.Text
+	errorMessage  *canvas.Text
 }
 
 func (r *textFieldRenderer) Destroy() {
@@ -177,6 +490,14 @@ func (r *textFieldRenderer) Destroy() {
 }
 
 func (r *textFieldRenderer) Layout(size fyne.Size) {
+	if r.showsErrorMessage() {
+		// The message sits below the field itself
+		messageSize := r.errorMessage.MinSize()
+		size.Height -= messageSize.Height + errorMessagePadding
+		r.errorMessage.Resize(messageSize)
+		r.errorMessage.Move(fyne.NewPos(r.textField.TextInsets.Left, size.Height+errorMessagePadding))
+	}
+
 	r.background.Resize(size)
 	r.border.Resize(size)
 
@@ -185,6 +506,30 @@ func (r *textFieldRenderer) Layout(size fyne.Size) {
 		size.Width-insets.Left-insets.Right,
 		size.Height-insets.Top-insets.Bottom,
 	)
+	// Reserve room at the trailing edge so text doesn't run under the glyphs
+	trailing := size.Width - insets.Right
+	if r.showsRevealButton() {
+		entrySize.Width -= revealButtonWidth + insets.Right
+		r.revealButton.Resize(fyne.NewSize(revealButtonWidth, clearButtonSize))
+		r.revealButton.Move(fyne.NewPos(trailing-revealButtonWidth, (size.Height-clearButtonSize)/2))
+		trailing -= revealButtonWidth + insets.Right
+	}
+	if r.textField.ShowsCharacterCount {
+		counterSize := r.counter.MinSize()
+		entrySize.Width -= counterSize.Width + insets.Right
+		r.counter.Resize(counterSize)
+		r.counter.Move(fyne.NewPos(trailing-counterSize.Width, size.Height-insets.Bottom-counterSize.Height))
+		trailing -= counterSize.Width + insets.Right
+	}
+	if r.textField.showsClearButton() {
+		entrySize.Width -= clearButtonSize + insets.Right
+		adjust := r.textField.ClearButtonPositionAdjustment
+		r.clearButton.Resize(fyne.NewSize(clearButtonSize, clearButtonSize))
+		r.clearButton.Move(fyne.NewPos(
+			trailing-clearButtonSize+adjust.X,
+			(size.Height-clearButtonSize)/2+adjust.Y,
+		))
+	}
 	// Note: We don't call Entry.Resize() here to avoid infinite recursion
 	// since Entry is embedded in TextField and shares the same widget identity.
 	// Instead, we position the entry content via the renderer.
@@ -194,24 +539,234 @@ func (r *textFieldRenderer) Layout(size fyne.Size) {
 func (r *textFieldRenderer) MinSize() fyne.Size {
 	entryMin := r.entryRenderer.MinSize()
 	insets := r.textField.TextInsets
-	return fyne.NewSize(
-		entryMin.Width+insets.Left+insets.Right,
-		entryMin.Height+insets.Top+insets.Bottom,
-	)
+	width := entryMin.Width + insets.Left + insets.Right
+	if r.textField.ClearButtonMode != ClearButtonNever {
+		width += clearButtonSize + insets.Right
+	}
+	if r.showsRevealButton() {
+		width += revealButtonWidth + insets.Right
+	}
+	if r.textField.ShowsCharacterCount {
+		width += r.counter.MinSize().Width + insets.Right
+	}
+	height := entryMin.Height + insets.Top + insets.Bottom
+	if r.showsErrorMessage() {
+		height += r.errorMessage.MinSize().Height + errorMessagePadding
+	}
+	return fyne.NewSize(width, height)
+}
+
+func (r *textFieldRenderer) showsErrorMessage() bool {
+	return r.textField.ShowsValidationMessage && r.textField.ValidationError() != nil
+}
+
+func (r *textFieldRenderer) showsRevealButton() bool {
+	return r.textField.Secure && r.textField.SecureEntryRevealable
 }
 
 func (r *textFieldRenderer) Refresh() {
+	r.textField.applySecureState()
+
+	config := core.SharedConfiguration()
+	r.background.FillColor = color.Transparent
+	if !r.textField.IsEnabled() {
+		r.background.FillColor = core.ColorWithAlpha(config.DisabledColor, disabledBackgroundAlpha)
+	}
+	r.border.StrokeColor = config.SeparatorColor
+	r.errorMessage.Text = ""
+	if err := r.textField.ValidationError(); err != nil {
+		r.border.StrokeColor = r.textField.ErrorBorderColor
+		r.errorMessage.Text = err.Error()
+	}
+	r.errorMessage.Color = r.textField.ErrorBorderColor
+
 	r.entryRenderer.Refresh()
 	r.background.Refresh()
 	r.border.Refresh()
+	r.errorMessage.Refresh()
+	r.clearButton.Refresh()
+	r.revealButton.Refresh()
+	r.refreshCounter()
+	r.Layout(r.textField.Size())
+}
+
+func (r *textFieldRenderer) refreshCounter() {
+	text, atLimit := r.textField.characterCountText()
+	r.counter.Text = text
+	r.counter.Color = r.textField.PlaceholderColor
+	if !r.textField.IsEnabled() {
+		r.counter.Color = core.SharedConfiguration().DisabledColor
+	} else if atLimit {
+		r.counter.Color = core.SharedConfiguration().RedColor
+	}
+	r.counter.Refresh()
 }
 
 func (r *textFieldRenderer) Objects() []fyne.CanvasObject {
 	objects := []fyne.CanvasObject{r.background, r.border}
 	objects = append(objects, r.entryRenderer.Objects()...)
+	if r.textField.showsClearButton() {
+		objects = append(objects, r.clearButton)
+	}
+	if r.showsRevealButton() {
+		objects = append(objects, r.revealButton)
+	}
+	if r.textField.ShowsCharacterCount {
+		objects = append(objects, r.counter)
+	}
+	if r.showsErrorMessage() {
+		objects = append(objects, r.errorMessage)
+	}
 	return objects
 }
 
+// clearButton is the circled cross shown at the trailing edge of a text field
+type clearButton struct {
+	widget.BaseWidget
+	textField *TextField
+}
+
+func newClearButton(tf *TextField) *clearButton {
+	b := &clearButton{textField: tf}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// Tapped handles tap events
+func (b *clearButton) Tapped(_ *fyne.PointEvent) {
+	b.textField.Clear()
+}
+
+// Cursor returns the cursor for this widget
+func (b *clearButton) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+// CreateRenderer implements fyne.Widget
+func (b *clearButton) CreateRenderer() fyne.WidgetRenderer {
+	circle := canvas.NewCircle(b.textField.PlaceholderColor)
+	line1 := canvas.NewLine(color.White)
+	line1.StrokeWidth = 1.5
+	line2 := canvas.NewLine(color.White)
+	line2.StrokeWidth = 1.5
+	return &clearButtonRenderer{button: b, circle: circle, line1: line1, line2: line2}
+}
+
+type clearButtonRenderer struct {
+	button *clearButton
+	circle *canvas.Circle
+	line1  *canvas.Line
+	line2  *canvas.Line
+}
+
+func (r *clearButtonRenderer) Destroy() {}
+
+func (r *clearButtonRenderer) Layout(size fyne.Size) {
+	r.circle.Resize(size)
+	inset := size.Width * 0.3
+	r.line1.Position1 = fyne.NewPos(inset, inset)
+	r.line1.Position2 = fyne.NewPos(size.Width-inset, size.Height-inset)
+	r.line2.Position1 = fyne.NewPos(size.Width-inset, inset)
+	r.line2.Position2 = fyne.NewPos(inset, size.Height-inset)
+}
+
+func (r *clearButtonRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(clearButtonSize, clearButtonSize)
+}
+
+func (r *clearButtonRenderer) Refresh() {
+	r.circle.FillColor = r.button.textField.PlaceholderColor
+	r.circle.Refresh()
+	r.line1.Refresh()
+	r.line2.Refresh()
+}
+
+func (r *clearButtonRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.circle, r.line1, r.line2}
+}
+
+// revealButton is the eye toggle shown at the trailing edge of a revealable secure field
+type revealButton struct {
+	widget.BaseWidget
+	textField *TextField
+}
+
+func newRevealButton(tf *TextField) *revealButton {
+	b := &revealButton{textField: tf}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// Tapped handles tap events
+func (b *revealButton) Tapped(_ *fyne.PointEvent) {
+	if !b.textField.IsEnabled() {
+		return
+	}
+	b.textField.SetSecureTextRevealed(!b.textField.IsSecureTextRevealed())
+}
+
+// Cursor returns the cursor for this widget
+func (b *revealButton) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+// CreateRenderer implements fyne.Widget
+func (b *revealButton) CreateRenderer() fyne.WidgetRenderer {
+	tint := b.textField.PlaceholderColor
+	outline := canvas.NewCircle(tint)
+	outline.FillColor = nil
+	outline.StrokeColor = tint
+	outline.StrokeWidth = 1.5
+	pupil := canvas.NewCircle(tint)
+	slash := canvas.NewLine(tint)
+	slash.StrokeWidth = 1.5
+	return &revealButtonRenderer{button: b, outline: outline, pupil: pupil, slash: slash}
+}
+
+type revealButtonRenderer struct {
+	button  *revealButton
+	outline *canvas.Circle
+	pupil   *canvas.Circle
+	slash   *canvas.Line
+}
+
+func (r *revealButtonRenderer) Destroy() {}
+
+func (r *revealButtonRenderer) Layout(size fyne.Size) {
+	eyeHeight := size.Height * 0.6
+	r.outline.Resize(fyne.NewSize(size.Width, eyeHeight))
+	r.outline.Move(fyne.NewPos(0, (size.Height-eyeHeight)/2))
+	pupilSize := eyeHeight * 0.6
+	r.pupil.Resize(fyne.NewSize(pupilSize, pupilSize))
+	r.pupil.Move(fyne.NewPos((size.Width-pupilSize)/2, (size.Height-pupilSize)/2))
+	r.slash.Position1 = fyne.NewPos(size.Width*0.15, size.Height)
+	r.slash.Position2 = fyne.NewPos(size.Width*0.85, 0)
+}
+
+func (r *revealButtonRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(revealButtonWidth, clearButtonSize)
+}
+
+func (r *revealButtonRenderer) Refresh() {
+	tint := r.button.textField.PlaceholderColor
+	r.outline.StrokeColor = tint
+	r.pupil.FillColor = tint
+	r.slash.StrokeColor = tint
+	// The slash marks the text as hidden
+	if r.button.textField.IsSecureTextRevealed() {
+		r.slash.Hide()
+	} else {
+		r.slash.Show()
+	}
+	r.outline.Refresh()
+	r.pupil.Refresh()
+	r.slash.Refresh()
+}
+
+func (r *revealButtonRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.outline, r.pupil, r.slash}
+}
+
 // PasswordTextField is a secure text field for passwords
 type PasswordTextField struct {
 	*TextField
@@ -220,6 +775,8 @@ type PasswordTextField struct {
 // NewPasswordTextField creates a password input field
 func NewPasswordTextField() *PasswordTextField {
 	tf := NewTextField()
+	tf.Secure = true
+	tf.SecureEntryRevealable = true
 	tf.Password = true
 	return &PasswordTextField{TextField: tf}
 }
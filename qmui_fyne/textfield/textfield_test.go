@@ -1,10 +1,15 @@
 package textfield
 
 import (
+	"errors"
+	"image/color"
+	"strings"
 	"testing"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/test"
+
+	"github.com/paul-hammant/qmui_fyne/core"
 )
 
 func TestTextField_VisualRendering(t *testing.T) {
@@ -115,3 +120,271 @@ func TestTextField_OnChangedCallback(t *testing.T) {
 
 	w.Close()
 }
+
+func TestTextField_ClearButton(t *testing.T) {
+	tf := NewTextField()
+	tf.ClearButtonMode = ClearButtonAlways
+	var lastText string
+	tf.OnTextChanged = func(text string) {
+		lastText = text
+	}
+
+	w := test.NewWindow(tf)
+	w.Resize(fyne.NewSize(200, 40))
+
+	renderer := test.WidgetRenderer(tf)
+	tf.SetText("hello")
+	renderer.Refresh()
+
+	objects := renderer.Objects()
+	button, ok := objects[len(objects)-1].(*clearButton)
+	if !ok {
+		t.Fatal("Clear button should be shown when the field has text")
+	}
+
+	test.Tap(button)
+
+	if tf.Text != "" {
+		t.Errorf("Text should be empty after tapping clear, got '%s'", tf.Text)
+	}
+	if lastText != "" {
+		t.Errorf("OnTextChanged should have received empty text, got '%s'", lastText)
+	}
+
+	objects = renderer.Objects()
+	if _, ok := objects[len(objects)-1].(*clearButton); ok {
+		t.Error("Clear button should be hidden when the field is empty")
+	}
+
+	w.Close()
+}
+
+func TestTextField_ClearButtonWhileEditing(t *testing.T) {
+	tf := NewTextField()
+	tf.ClearButtonMode = ClearButtonWhileEditing
+
+	w := test.NewWindow(tf)
+	tf.SetText("hello")
+
+	if tf.showsClearButton() {
+		t.Error("Clear button should be hidden when not editing")
+	}
+
+	w.Canvas().Focus(tf)
+	if !tf.showsClearButton() {
+		t.Error("Clear button should be shown while editing")
+	}
+
+	w.Close()
+}
+
+func TestTextField_SecureEntry(t *testing.T) {
+	tf := NewTextField()
+	tf.Secure = true
+	tf.SecureEntryRevealable = true
+	tf.MaximumTextLength = 5
+
+	w := test.NewWindow(tf)
+	w.Resize(fyne.NewSize(200, 40))
+
+	renderer := test.WidgetRenderer(tf)
+	tf.Entry.SetText("secret123")
+	renderer.Refresh()
+
+	if tf.Text != "secre" {
+		t.Errorf("Text should be the real value limited to 5 chars, got '%s'", tf.Text)
+	}
+	if !tf.Password {
+		t.Error("Secure field should mask its text")
+	}
+
+	objects := renderer.Objects()
+	toggle, ok := objects[len(objects)-1].(*revealButton)
+	if !ok {
+		t.Fatal("Revealable secure field should show the reveal toggle")
+	}
+
+	test.Tap(toggle)
+	if tf.Password || !tf.IsSecureTextRevealed() {
+		t.Error("Tapping the reveal toggle should show the plain text")
+	}
+
+	test.Tap(toggle)
+	if !tf.Password {
+		t.Error("Tapping the reveal toggle again should mask the text")
+	}
+
+	w.Close()
+}
+
+func TestTextField_InputMask(t *testing.T) {
+	tf := NewTextField()
+	tf.InputMask = "(###) ###-####"
+	var lastText string
+	tf.OnTextChanged = func(text string) {
+		lastText = text
+	}
+
+	w := test.NewWindow(tf)
+
+	tf.Entry.SetText("5551234")
+	if tf.Text != "(555) 123-4" {
+		t.Errorf("Text should be formatted, got '%s'", tf.Text)
+	}
+	if tf.RawText() != "5551234" {
+		t.Errorf("RawText should be digits only, got '%s'", tf.RawText())
+	}
+	if lastText != "(555) 123-4" {
+		t.Errorf("OnTextChanged should receive the formatted text, got '%s'", lastText)
+	}
+
+	// Backspace just after a separator removes the digit before it
+	tf.Entry.SetText("5551")
+	tf.CursorColumn = 6
+	tf.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	if tf.RawText() != "551" {
+		t.Errorf("Backspace should skip literals, raw text is '%s'", tf.RawText())
+	}
+
+	w.Close()
+}
+
+func TestTextField_InputMaskMaxLength(t *testing.T) {
+	tf := NewTextField()
+	tf.InputMask = "#### #### #### ####"
+	tf.MaximumTextLength = 6
+
+	w := test.NewWindow(tf)
+
+	tf.Entry.SetText("1234567890")
+	if tf.Text != "1234 56" {
+		t.Errorf("MaximumTextLength should count raw characters, got '%s'", tf.Text)
+	}
+
+	w.Close()
+}
+
+func TestTextField_CharacterCount(t *testing.T) {
+	tf := NewTextField()
+	tf.MaximumTextLength = 5
+	tf.ShowsCharacterCount = true
+	reached := 0
+	tf.OnReachedMaxLength = func() {
+		reached++
+	}
+
+	w := test.NewWindow(tf)
+	w.Resize(fyne.NewSize(200, 40))
+
+	renderer := test.WidgetRenderer(tf).(*textFieldRenderer)
+	tf.Entry.SetText("abc")
+	renderer.Refresh()
+
+	if renderer.counter.Text != "3/5" {
+		t.Errorf("Counter should read '3/5', got '%s'", renderer.counter.Text)
+	}
+	if reached != 0 {
+		t.Error("OnReachedMaxLength should not fire below the limit")
+	}
+
+	tf.Entry.SetText("abcdefgh")
+	renderer.Refresh()
+
+	if renderer.counter.Text != "5/5" {
+		t.Errorf("Counter should read '5/5', got '%s'", renderer.counter.Text)
+	}
+	if renderer.counter.Color != core.SharedConfiguration().RedColor {
+		t.Error("Counter should turn red at the limit")
+	}
+	if reached != 1 {
+		t.Errorf("OnReachedMaxLength should fire once, fired %d times", reached)
+	}
+
+	w.Close()
+}
+
+func TestTextField_Validator(t *testing.T) {
+	tf := NewTextField()
+	tf.ShowsValidationMessage = true
+	tf.Validator = func(text string) error {
+		if !strings.Contains(text, "@") {
+			return errors.New("Enter a valid email")
+		}
+		return nil
+	}
+
+	w := test.NewWindow(tf)
+	renderer := test.WidgetRenderer(tf).(*textFieldRenderer)
+	validHeight := renderer.MinSize().Height
+
+	tf.SetText("paul")
+	renderer.Refresh()
+
+	if tf.IsValid() {
+		t.Error("Text without '@' should be invalid")
+	}
+	if renderer.border.StrokeColor != tf.ErrorBorderColor {
+		t.Error("Invalid field should show the error border")
+	}
+	if renderer.errorMessage.Text != "Enter a valid email" {
+		t.Errorf("Inline message should show the error, got '%s'", renderer.errorMessage.Text)
+	}
+	if renderer.MinSize().Height <= validHeight {
+		t.Error("Inline error message should add to the field height")
+	}
+
+	tf.SetText("paul@example.com")
+	renderer.Refresh()
+
+	if !tf.IsValid() || tf.ValidationError() != nil {
+		t.Error("Text with '@' should be valid")
+	}
+	if renderer.border.StrokeColor == tf.ErrorBorderColor {
+		t.Error("Valid field should not show the error border")
+	}
+
+	w.Close()
+}
+
+func TestTextField_Disabled(t *testing.T) {
+	tf := NewTextField()
+	tf.ClearButtonMode = ClearButtonAlways
+	tf.SetText("paul")
+
+	w := test.NewWindow(tf)
+	w.Resize(fyne.NewSize(200, 40))
+	renderer := test.WidgetRenderer(tf).(*textFieldRenderer)
+
+	tf.SetEnabled(false)
+	if tf.IsEnabled() || !tf.Disabled() {
+		t.Fatal("SetEnabled(false) should disable the field")
+	}
+	if renderer.background.FillColor == color.Transparent {
+		t.Error("Disabled field should show a muted background")
+	}
+	for _, obj := range renderer.Objects() {
+		if _, ok := obj.(*clearButton); ok {
+			t.Error("Disabled field should not show the clear button")
+		}
+	}
+
+	test.Type(tf, "!")
+	if tf.Text != "paul" {
+		t.Errorf("Disabled field should ignore typing, got '%s'", tf.Text)
+	}
+
+	tf.Enable()
+	if !tf.IsEnabled() {
+		t.Fatal("Enable should enable the field")
+	}
+	if renderer.background.FillColor != color.Transparent {
+		t.Error("Enabled field should not show the muted background")
+	}
+	w.Canvas().Focus(tf)
+	test.Type(tf, "!")
+	if !strings.Contains(tf.Text, "!") {
+		t.Errorf("Enabled field should accept typing, got '%s'", tf.Text)
+	}
+
+	w.Close()
+}
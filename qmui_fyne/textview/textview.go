@@ -3,12 +3,18 @@
 package textview
 
 import (
+	"fmt"
 	"image/color"
+	"net/url"
+	"strconv"
+	"strings"
 	"sync"
+	"unicode"
 	"unicode/utf8"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
@@ -41,6 +47,14 @@ type TextView struct {
 	ShouldCountingNonASCIICharacterAsTwo        bool
 	MaximumHeight                               float32
 	IsDeletingDuringTextChange                  bool
+	ShowsCharacterCount                         bool // Shows a "12/50" counter at the bottom-right
+	AutoGrow                                    bool // Grows with content between MinLines and MaxLines
+	MinLines                                    int
+	MaxLines                                    int  // 0 means no limit; beyond it the text scrolls
+	Editable                                    bool // When false the text can be selected and copied but not changed
+	Markdown                                    bool // Renders bold, italic, lists and links; disables editing
+	Enabled                                     bool // When false the view is dimmed and ignores input; set with SetEnabled
+	MaximumUndoCount                            int  // Edits kept for Undo, oldest dropped first; 0 means no limit
 
 	// Delegate
 	Delegate TextViewDelegate
@@ -49,9 +63,28 @@ type TextView struct {
 	OnTextChanged      func(text string)
 	OnHeightChanged    func(newHeight float32)
 	OnPaste            func(sender interface{}) bool
+	OnReachedMaxLength func()
+	OnLinkTapped       func(link *url.URL) // Replaces opening the link in Markdown mode
 
 	mu            sync.RWMutex
 	lastHeight    float32
+	autoGrowRows  int
+
+	// Edit history for Undo and Redo
+	undoStack       []textEdit
+	redoStack       []textEdit
+	historyText     string
+	applyingHistory bool
+}
+
+// defaultMaximumUndoCount is how many edits a text view can undo by default
+const defaultMaximumUndoCount = 100
+
+// textEdit replaces removed with inserted at a rune position
+type textEdit struct {
+	position int
+	removed  []rune
+	inserted []rune
 }
 
 // NewTextView creates a new QMUI-styled text view
@@ -66,6 +99,10 @@ func NewTextView() *TextView {
 	}
 	tv.MultiLine = true
 	tv.Wrapping = fyne.TextWrapWord
+	tv.MinLines = 1
+	tv.Editable = true
+	tv.Enabled = true
+	tv.MaximumUndoCount = defaultMaximumUndoCount
 	tv.ExtendBaseWidget(tv)
 	tv.Entry.OnChanged = tv.handleTextChanged
 	return tv
@@ -86,11 +123,368 @@ func (tv *TextView) SetText(text string) {
 	tv.mu.Unlock()
 
 	tv.Entry.SetText(text)
+	tv.clearHistory()
 	if shouldNotify && tv.OnTextChanged != nil {
 		tv.OnTextChanged(text)
 	}
 }
 
+// Undo reverts the last edit. Consecutive typing is undone as one edit per word.
+func (tv *TextView) Undo() {
+	if !tv.IsEditable() || !tv.IsEnabled() {
+		return
+	}
+	if text, cursor, ok := tv.stepHistory(true); ok {
+		tv.applyHistoryText(text, cursor)
+	}
+}
+
+// Redo applies the last edit reverted by Undo
+func (tv *TextView) Redo() {
+	if !tv.IsEditable() || !tv.IsEnabled() {
+		return
+	}
+	if text, cursor, ok := tv.stepHistory(false); ok {
+		tv.applyHistoryText(text, cursor)
+	}
+}
+
+// stepHistory moves the last edit from the undo stack to the redo stack, or
+// back when undo is false, returning the text with the edit reverted or
+// reapplied and the rune position of the cursor after it. If the text no
+// longer holds what the edit left at its position, as after the text was
+// changed without being recorded, the history is cleared instead.
+func (tv *TextView) stepHistory(undo bool) (string, int, bool) {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+
+	from, to := &tv.undoStack, &tv.redoStack
+	if !undo {
+		from, to = to, from
+	}
+	if len(*from) == 0 {
+		return "", 0, false
+	}
+	edit := (*from)[len(*from)-1]
+	current, replacement := edit.inserted, edit.removed
+	if !undo {
+		current, replacement = replacement, current
+	}
+
+	runes := []rune(tv.Text)
+	end := edit.position + len(current)
+	if end > len(runes) || string(runes[edit.position:end]) != string(current) {
+		tv.undoStack = nil
+		tv.redoStack = nil
+		tv.historyText = tv.Text
+		return "", 0, false
+	}
+
+	*from = (*from)[:len(*from)-1]
+	*to = append(*to, edit)
+	text := string(runes[:edit.position]) + string(replacement) + string(runes[end:])
+	return text, edit.position + len(replacement), true
+}
+
+// CanUndo returns whether there is an edit to undo
+func (tv *TextView) CanUndo() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return len(tv.undoStack) > 0
+}
+
+// CanRedo returns whether there is an undone edit to redo
+func (tv *TextView) CanRedo() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return len(tv.redoStack) > 0
+}
+
+// applyHistoryText sets text from Undo or Redo without recording it as an
+// edit, placing the cursor at the rune position cursor
+func (tv *TextView) applyHistoryText(text string, cursor int) {
+	tv.mu.Lock()
+	tv.applyingHistory = true
+	tv.mu.Unlock()
+
+	// Notifies through handleTextChanged
+	tv.Entry.SetText(text)
+
+	tv.mu.Lock()
+	tv.applyingHistory = false
+	tv.mu.Unlock()
+
+	before := []rune(text)[:cursor]
+	tv.CursorRow = strings.Count(string(before), "\n")
+	tv.CursorColumn = len(before)
+	if i := strings.LastIndex(string(before), "\n"); i >= 0 {
+		tv.CursorColumn = utf8.RuneCountInString(string(before)[i+1:])
+	}
+	tv.Entry.Refresh()
+}
+
+// recordEdit adds the change from the previous text to text to the undo
+// stack, merging it into the last edit while the same word is being typed
+func (tv *TextView) recordEdit(text string) {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+
+	previous := tv.historyText
+	tv.historyText = text
+	if tv.applyingHistory || previous == text {
+		return
+	}
+
+	old, current := []rune(previous), []rune(text)
+	prefix := 0
+	for prefix < len(old) && prefix < len(current) && old[prefix] == current[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(old)-prefix && suffix < len(current)-prefix &&
+		old[len(old)-1-suffix] == current[len(current)-1-suffix] {
+		suffix++
+	}
+	edit := textEdit{
+		position: prefix,
+		removed:  old[prefix : len(old)-suffix],
+		inserted: current[prefix : len(current)-suffix],
+	}
+
+	tv.redoStack = nil
+	if n := len(tv.undoStack); n > 0 && continuesTyping(tv.undoStack[n-1], edit) {
+		tv.undoStack[n-1].inserted = append(tv.undoStack[n-1].inserted, edit.inserted...)
+		return
+	}
+	tv.undoStack = append(tv.undoStack, edit)
+	if tv.MaximumUndoCount > 0 && len(tv.undoStack) > tv.MaximumUndoCount {
+		tv.undoStack = tv.undoStack[len(tv.undoStack)-tv.MaximumUndoCount:]
+	}
+}
+
+// clearHistory forgets the edits, as after the text is set programmatically
+func (tv *TextView) clearHistory() {
+	tv.mu.Lock()
+	tv.undoStack = nil
+	tv.redoStack = nil
+	tv.historyText = tv.Text
+	tv.mu.Unlock()
+}
+
+// continuesTyping returns whether next types a single character right after
+// last's insertion without starting a new word
+func continuesTyping(last, next textEdit) bool {
+	if len(last.removed) > 0 || len(next.removed) > 0 || len(last.inserted) == 0 || len(next.inserted) != 1 {
+		return false
+	}
+	if last.position+len(last.inserted) != next.position {
+		return false
+	}
+	lastRune := last.inserted[len(last.inserted)-1]
+	return !unicode.IsSpace(next.inserted[0]) || unicode.IsSpace(lastRune)
+}
+
+// SetEditable switches between editing and a read-only display whose text
+// can still be selected and copied
+func (tv *TextView) SetEditable(editable bool) {
+	tv.mu.Lock()
+	tv.Editable = editable
+	tv.mu.Unlock()
+
+	if !editable {
+		// Hides the caret if the view has focus
+		tv.Entry.FocusLost()
+	}
+	tv.Refresh()
+}
+
+// IsEditable returns whether the text can be changed by typing
+func (tv *TextView) IsEditable() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.Editable && !tv.Markdown
+}
+
+// SetEnabled sets whether the view accepts input. Unlike a read-only view, a
+// disabled view is dimmed and its text can't be selected.
+func (tv *TextView) SetEnabled(enabled bool) {
+	tv.mu.Lock()
+	tv.Enabled = enabled
+	tv.mu.Unlock()
+
+	// The entry blocks typing, selection and focus while disabled
+	if enabled {
+		tv.Entry.Enable()
+	} else {
+		tv.Entry.Disable()
+	}
+	tv.Refresh()
+}
+
+// IsEnabled returns whether the view accepts input
+func (tv *TextView) IsEnabled() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.Enabled
+}
+
+// Enable enables the view, implementing fyne.Disableable
+func (tv *TextView) Enable() {
+	tv.SetEnabled(true)
+}
+
+// Disable disables the view, implementing fyne.Disableable
+func (tv *TextView) Disable() {
+	tv.SetEnabled(false)
+}
+
+// Disabled returns whether the view is disabled, so focus traversal skips it
+func (tv *TextView) Disabled() bool {
+	return !tv.IsEnabled()
+}
+
+// SetMarkdown switches between plain text and rendering the text as
+// markdown, with bold, italic, bullet lists and tappable links
+func (tv *TextView) SetMarkdown(markdown bool) {
+	tv.mu.Lock()
+	tv.Markdown = markdown
+	tv.mu.Unlock()
+
+	if markdown {
+		tv.Entry.FocusLost()
+	}
+	tv.Refresh()
+}
+
+// IsMarkdown returns whether the text is rendered as markdown
+func (tv *TextView) IsMarkdown() bool {
+	tv.mu.RLock()
+	defer tv.mu.RUnlock()
+	return tv.Markdown
+}
+
+// hookLinks routes taps on the markdown links to linkTapped
+func (tv *TextView) hookLinks(segments []widget.RichTextSegment) {
+	for _, segment := range segments {
+		switch s := segment.(type) {
+		case *widget.HyperlinkSegment:
+			link := s.URL
+			s.OnTapped = func() {
+				tv.linkTapped(link)
+			}
+		case *widget.ParagraphSegment:
+			tv.hookLinks(s.Texts)
+		case *widget.ListSegment:
+			tv.hookLinks(s.Items)
+		}
+	}
+}
+
+func (tv *TextView) linkTapped(link *url.URL) {
+	if tv.OnLinkTapped != nil {
+		tv.OnLinkTapped(link)
+		return
+	}
+	if link != nil {
+		_ = fyne.CurrentApp().OpenURL(link)
+	}
+}
+
+// FocusGained implements fyne.Focusable. Read-only views take focus so
+// shortcuts reach them, but don't show the caret.
+func (tv *TextView) FocusGained() {
+	if tv.IsEditable() {
+		tv.Entry.FocusGained()
+		core.NotifyFocusChanged(tv, true)
+	}
+}
+
+// FocusLost handles focus lost events
+func (tv *TextView) FocusLost() {
+	tv.Entry.FocusLost()
+	core.NotifyFocusChanged(tv, false)
+}
+
+// DoubleTapped selects the word under the pointer
+func (tv *TextView) DoubleTapped(ev *fyne.PointEvent) {
+	tv.Entry.DoubleTapped(ev)
+	if !tv.IsEditable() {
+		tv.Entry.FocusLost()
+	}
+}
+
+// AcceptsTab lets Tab move focus out of read-only views
+func (tv *TextView) AcceptsTab() bool {
+	return tv.IsEditable() && tv.Entry.AcceptsTab()
+}
+
+// TypedRune implements fyne.Focusable
+func (tv *TextView) TypedRune(r rune) {
+	if tv.IsEditable() {
+		tv.Entry.TypedRune(r)
+	}
+}
+
+// TypedKey implements fyne.Focusable. Escape dismisses the popup the view is
+// shown in, if any.
+func (tv *TextView) TypedKey(key *fyne.KeyEvent) {
+	if key.Name == fyne.KeyEscape && core.SharedOverlayManager().DismissTopFor(tv) {
+		return
+	}
+	if tv.IsEditable() {
+		tv.Entry.TypedKey(key)
+	}
+}
+
+// TypedShortcut implements fyne.Shortcutable. Read-only views only copy
+// and select all.
+func (tv *TextView) TypedShortcut(shortcut fyne.Shortcut) {
+	switch s := shortcut.(type) {
+	case *fyne.ShortcutUndo:
+		tv.Undo()
+		return
+	case *fyne.ShortcutRedo:
+		tv.Redo()
+		return
+	case *desktop.CustomShortcut:
+		if s.KeyName == fyne.KeyZ && s.Modifier == fyne.KeyModifierShortcutDefault|fyne.KeyModifierShift {
+			tv.Redo()
+			return
+		}
+	}
+
+	if !tv.IsEditable() {
+		switch shortcut.(type) {
+		case *fyne.ShortcutCopy, *fyne.ShortcutSelectAll:
+		default:
+			return
+		}
+	}
+	tv.Entry.TypedShortcut(shortcut)
+}
+
+// TappedSecondary shows the edit menu, offering only Copy and Select All
+// in read-only views
+func (tv *TextView) TappedSecondary(ev *fyne.PointEvent) {
+	if tv.IsEditable() {
+		tv.Entry.TappedSecondary(ev)
+		return
+	}
+
+	clipboard := fyne.CurrentApp().Clipboard()
+	menu := fyne.NewMenu("",
+		fyne.NewMenuItem("Copy", func() {
+			tv.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+		}),
+		fyne.NewMenuItem("Select All", func() {
+			tv.TypedShortcut(&fyne.ShortcutSelectAll{})
+		}),
+	)
+	driver := fyne.CurrentApp().Driver()
+	position := driver.AbsolutePositionForObject(tv).Add(ev.Position)
+	widget.ShowPopUpMenuAtPosition(menu, driver.CanvasForObject(tv), position)
+}
+
 // handleTextChanged processes text changes with length limiting
 func (tv *TextView) handleTextChanged(text string) {
 	tv.mu.RLock()
@@ -100,6 +494,10 @@ func (tv *TextView) handleTextChanged(text string) {
 
 	if maxLen > 0 {
 		length := tv.calculateTextLength(text, countNonASCII)
+		// Trimmed text re-enters here, so reaching the limit is reported once
+		if length == maxLen && tv.OnReachedMaxLength != nil {
+			defer tv.OnReachedMaxLength()
+		}
 		if length > maxLen {
 			// Trim text to max length
 			trimmed := tv.trimToLength(text, maxLen, countNonASCII)
@@ -111,14 +509,64 @@ func (tv *TextView) handleTextChanged(text string) {
 		}
 	}
 
+	tv.recordEdit(text)
+
 	if tv.OnTextChanged != nil {
 		tv.OnTextChanged(text)
 	}
 
 	// Check height change
+	tv.updateAutoGrowRows(text)
 	tv.checkHeightChange()
 }
 
+// updateAutoGrowRows sizes the entry to the number of lines in text, clamped
+// to MinLines and MaxLines, when AutoGrow is enabled
+func (tv *TextView) updateAutoGrowRows(text string) {
+	tv.mu.RLock()
+	autoGrow := tv.AutoGrow
+	minLines := tv.MinLines
+	maxLines := tv.MaxLines
+	tv.mu.RUnlock()
+
+	if !autoGrow {
+		return
+	}
+	lines := strings.Count(text, "\n") + 1
+	if lines < minLines {
+		lines = minLines
+	}
+	if maxLines > 0 && lines > maxLines {
+		lines = maxLines
+	}
+
+	// SetMinRowsVisible refreshes, which comes back here through the renderer
+	tv.mu.Lock()
+	changed := lines != tv.autoGrowRows
+	tv.autoGrowRows = lines
+	tv.mu.Unlock()
+	if changed {
+		tv.SetMinRowsVisible(lines)
+	}
+}
+
+// CharacterCount returns the length of the text as counted against MaximumTextLength
+func (tv *TextView) CharacterCount() int {
+	tv.mu.RLock()
+	countNonASCII := tv.ShouldCountingNonASCIICharacterAsTwo
+	tv.mu.RUnlock()
+	return tv.calculateTextLength(tv.Text, countNonASCII)
+}
+
+// characterCountText returns the counter label and whether the limit is reached
+func (tv *TextView) characterCountText() (string, bool) {
+	count := tv.CharacterCount()
+	if tv.MaximumTextLength > 0 {
+		return fmt.Sprintf("%d/%d", count, tv.MaximumTextLength), count >= tv.MaximumTextLength
+	}
+	return strconv.Itoa(count), false
+}
+
 func (tv *TextView) calculateTextLength(s string, countNonASCIIAsTwo bool) int {
 	if !countNonASCIIAsTwo {
 		return utf8.RuneCountInString(s)
@@ -196,25 +644,57 @@ func (tv *TextView) CreateRenderer() fyne.WidgetRenderer {
 
 	placeholder := canvas.NewText(tv.Placeholder, tv.PlaceholderColor)
 
+	counter := canvas.NewText("", tv.PlaceholderColor)
+	counter.TextSize = characterCountTextSize
+	counter.Alignment = fyne.TextAlignTrailing
+
+	markdown := widget.NewRichText()
+	markdown.Wrapping = fyne.TextWrapWord
+
 	entryRenderer := tv.Entry.CreateRenderer()
 
-	return &textViewRenderer{
+	r := &textViewRenderer{
 		textView:      tv,
 		background:    background,
 		border:        border,
 		placeholder:   placeholder,
+		counter:       counter,
+		markdown:      markdown,
 		entryRenderer: entryRenderer,
 	}
+	r.updateMarkdown()
+	return r
 }
 
+const (
+	characterCountTextSize = 12
+	characterCountPadding  = 4
+
+	// disabledBackgroundAlpha is the alpha of DisabledColor behind a disabled view
+	disabledBackgroundAlpha = 0.2
+)
+
 type textViewRenderer struct {
 	textView      *TextView
 	background    *canvas.Rectangle
 	border        *canvas.Rectangle
 	placeholder   *canvas.Text
+	counter       *canvas.Text
+	markdown      *widget.RichText
+	markdownText  string
 	entryRenderer fyne.WidgetRenderer
 }
 
+// updateMarkdown parses the text when it changed since the last parse
+func (r *textViewRenderer) updateMarkdown() {
+	if !r.textView.IsMarkdown() || (r.markdownText == r.textView.Text && len(r.markdown.Segments) > 0) {
+		return
+	}
+	r.markdownText = r.textView.Text
+	r.markdown.ParseMarkdown(r.markdownText)
+	r.textView.hookLinks(r.markdown.Segments)
+}
+
 func (r *textViewRenderer) Destroy() {
 	r.entryRenderer.Destroy()
 }
@@ -226,12 +706,32 @@ func (r *textViewRenderer) Layout(size fyne.Size) {
 	margins := r.textView.PlaceholderMargins
 	r.placeholder.Move(fyne.NewPos(margins.Left+4, margins.Top+4))
 
+	entrySize := size
+	if r.textView.ShowsCharacterCount {
+		// The counter sits on its own row below the text
+		counterSize := r.counter.MinSize()
+		entrySize.Height -= counterSize.Height + characterCountPadding
+		r.counter.Resize(counterSize)
+		r.counter.Move(fyne.NewPos(
+			size.Width-counterSize.Width-characterCountPadding,
+			size.Height-counterSize.Height-characterCountPadding,
+		))
+	}
+
+	// Entry shares the widget identity, so it keeps the full size and
+	// only its content is laid out in the reduced area
 	r.textView.Entry.Resize(size)
-	r.entryRenderer.Layout(size)
+	r.entryRenderer.Layout(entrySize)
+
+	r.markdown.Move(fyne.NewPos(0, 0))
+	r.markdown.Resize(entrySize)
 }
 
 func (r *textViewRenderer) MinSize() fyne.Size {
 	minSize := r.entryRenderer.MinSize()
+	if r.textView.IsMarkdown() {
+		minSize = r.markdown.MinSize()
+	}
 
 	r.textView.mu.RLock()
 	maxHeight := r.textView.MaximumHeight
@@ -240,30 +740,65 @@ func (r *textViewRenderer) MinSize() fyne.Size {
 	if maxHeight > 0 && minSize.Height > maxHeight {
 		minSize.Height = maxHeight
 	}
+	if r.textView.ShowsCharacterCount {
+		minSize.Height += r.counter.MinSize().Height + characterCountPadding
+	}
 
 	return minSize
 }
 
 func (r *textViewRenderer) Refresh() {
+	r.textView.updateAutoGrowRows(r.textView.Text)
+
+	config := core.SharedConfiguration()
+	enabled := r.textView.IsEnabled()
+	r.background.FillColor = color.Transparent
+	if !enabled {
+		r.background.FillColor = core.ColorWithAlpha(config.DisabledColor, disabledBackgroundAlpha)
+	}
+
 	// Show/hide placeholder based on text content
 	if r.textView.Text == "" && r.textView.Placeholder != "" {
 		r.placeholder.Text = r.textView.Placeholder
 		r.placeholder.Color = r.textView.PlaceholderColor
+		if !enabled {
+			r.placeholder.Color = config.DisabledColor
+		}
 		r.placeholder.Show()
 	} else {
 		r.placeholder.Hide()
 	}
 
+	text, atLimit := r.textView.characterCountText()
+	r.counter.Text = text
+	r.counter.Color = r.textView.PlaceholderColor
+	if !enabled {
+		r.counter.Color = config.DisabledColor
+	} else if atLimit {
+		r.counter.Color = config.RedColor
+	}
+
+	r.updateMarkdown()
+	r.markdown.Refresh()
+
 	r.entryRenderer.Refresh()
 	r.background.Refresh()
 	r.border.Refresh()
 	r.placeholder.Refresh()
+	r.counter.Refresh()
 }
 
 func (r *textViewRenderer) Objects() []fyne.CanvasObject {
 	objects := []fyne.CanvasObject{r.background, r.border}
-	objects = append(objects, r.entryRenderer.Objects()...)
+	if r.textView.IsMarkdown() {
+		objects = append(objects, r.markdown)
+	} else {
+		objects = append(objects, r.entryRenderer.Objects()...)
+	}
 	objects = append(objects, r.placeholder)
+	if r.textView.ShowsCharacterCount {
+		objects = append(objects, r.counter)
+	}
 	return objects
 }
 
@@ -276,6 +811,7 @@ type AutoGrowingTextView struct {
 // NewAutoGrowingTextView creates a text view that grows with content
 func NewAutoGrowingTextView() *AutoGrowingTextView {
 	tv := NewTextView()
+	tv.AutoGrow = true
 	return &AutoGrowingTextView{
 		TextView:  tv,
 		MinHeight: 80,
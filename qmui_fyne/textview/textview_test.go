@@ -1,10 +1,14 @@
 package textview
 
 import (
+	"image/color"
+	"net/url"
 	"testing"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
 )
 
 func TestTextView_VisualRendering(t *testing.T) {
@@ -83,3 +87,271 @@ func TestTextView_PlaceholderWhenEmpty(t *testing.T) {
 
 	w.Close()
 }
+
+func TestTextView_CharacterCount(t *testing.T) {
+	tv := NewTextView()
+	tv.MaximumTextLength = 10
+	tv.ShowsCharacterCount = true
+	reached := false
+	tv.OnReachedMaxLength = func() {
+		reached = true
+	}
+
+	w := test.NewWindow(tv)
+	renderer := test.WidgetRenderer(tv).(*textViewRenderer)
+
+	tv.SetText("bio")
+	renderer.Refresh()
+	if renderer.counter.Text != "3/10" {
+		t.Errorf("Counter should read '3/10', got '%s'", renderer.counter.Text)
+	}
+
+	tv.SetText("a much longer caption")
+	renderer.Refresh()
+	if renderer.counter.Text != "10/10" {
+		t.Errorf("Counter should read '10/10', got '%s'", renderer.counter.Text)
+	}
+	if !reached {
+		t.Error("OnReachedMaxLength should fire at the limit")
+	}
+
+	w.Close()
+}
+
+func TestTextView_AutoGrow(t *testing.T) {
+	tv := NewTextViewWithPlaceholder("Message")
+	tv.AutoGrow = true
+	tv.MinLines = 1
+	tv.MaxLines = 3
+	var heights []float32
+	tv.OnHeightChanged = func(newHeight float32) {
+		heights = append(heights, newHeight)
+	}
+
+	w := test.NewWindow(tv)
+	renderer := test.WidgetRenderer(tv)
+	renderer.Refresh()
+
+	oneLine := renderer.MinSize().Height
+
+	tv.SetText("one\ntwo\nthree")
+	threeLines := renderer.MinSize().Height
+	if threeLines <= oneLine {
+		t.Errorf("Height should grow with lines: 1 line %v, 3 lines %v", oneLine, threeLines)
+	}
+
+	tv.SetText("1\n2\n3\n4\n5\n6")
+	if renderer.MinSize().Height != threeLines {
+		t.Errorf("Height should stop growing at MaxLines, got %v want %v", renderer.MinSize().Height, threeLines)
+	}
+
+	if len(heights) == 0 {
+		t.Error("OnHeightChanged should fire as the view grows")
+	}
+
+	tv.SetText("")
+	renderer.Refresh()
+	if renderer.MinSize().Height != oneLine {
+		t.Errorf("Height should shrink back to MinLines, got %v want %v", renderer.MinSize().Height, oneLine)
+	}
+	if tv.PlaceHolder != "Message" {
+		t.Error("Placeholder should be preserved")
+	}
+
+	w.Close()
+}
+
+func TestTextView_ReadOnly(t *testing.T) {
+	tv := NewTextView()
+	tv.SetText("Message body")
+
+	w := test.NewWindow(tv)
+	defer w.Close()
+
+	if !tv.IsEditable() {
+		t.Fatal("TextView should be editable by default")
+	}
+
+	tv.SetEditable(false)
+	w.Canvas().Focus(tv)
+	test.Type(tv, "typed")
+	tv.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	tv.TypedShortcut(&fyne.ShortcutPaste{Clipboard: test.NewClipboard()})
+	if tv.Text != "Message body" {
+		t.Errorf("Read-only text should not change, got %q", tv.Text)
+	}
+
+	clipboard := test.NewClipboard()
+	tv.TypedShortcut(&fyne.ShortcutSelectAll{})
+	tv.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+	if clipboard.Content() != "Message body" {
+		t.Errorf("Read-only text should still copy, got %q", clipboard.Content())
+	}
+	if tv.AcceptsTab() {
+		t.Error("Tab should move focus out of a read-only view")
+	}
+
+	tv.SetEditable(true)
+	test.Type(tv, "typed")
+	if tv.Text == "Message body" {
+		t.Error("Editable text should change when typed into")
+	}
+}
+
+func TestTextView_Markdown(t *testing.T) {
+	tv := NewTextView()
+	tv.SetText("**Bold** and *italic*\n\n* one\n* see [docs](https://example.com/help)")
+
+	w := test.NewWindow(tv)
+	defer w.Close()
+
+	var tapped *url.URL
+	tv.OnLinkTapped = func(link *url.URL) { tapped = link }
+	tv.SetMarkdown(true)
+	if tv.IsEditable() {
+		t.Error("Markdown mode should disable editing")
+	}
+
+	renderer := test.WidgetRenderer(tv).(*textViewRenderer)
+	found := false
+	for _, o := range renderer.Objects() {
+		found = found || o == renderer.markdown
+	}
+	if !found {
+		t.Fatal("Markdown mode should render the rich text")
+	}
+
+	var link *widget.HyperlinkSegment
+	var bold bool
+	var walk func([]widget.RichTextSegment)
+	walk = func(segments []widget.RichTextSegment) {
+		for _, segment := range segments {
+			switch s := segment.(type) {
+			case *widget.HyperlinkSegment:
+				link = s
+			case *widget.TextSegment:
+				bold = bold || (s.Text == "Bold" && s.Style.TextStyle.Bold)
+			case *widget.ParagraphSegment:
+				walk(s.Texts)
+			case *widget.ListSegment:
+				walk(s.Items)
+			}
+		}
+	}
+	walk(renderer.markdown.Segments)
+	if !bold {
+		t.Error("**Bold** should render as a bold run")
+	}
+	if link == nil || link.OnTapped == nil {
+		t.Fatal("Links inside lists should be tappable")
+	}
+	link.OnTapped()
+	if tapped == nil || tapped.String() != "https://example.com/help" {
+		t.Errorf("OnLinkTapped should receive the link URL, got %v", tapped)
+	}
+
+	tv.SetMarkdown(false)
+	if !tv.IsEditable() {
+		t.Error("Plain mode should be editable again")
+	}
+}
+
+func TestTextView_Disabled(t *testing.T) {
+	tv := NewTextViewWithPlaceholder("Notes")
+
+	w := test.NewWindow(tv)
+	w.Resize(fyne.NewSize(200, 100))
+	renderer := test.WidgetRenderer(tv).(*textViewRenderer)
+
+	tv.SetEnabled(false)
+	if tv.IsEnabled() || !tv.Disabled() {
+		t.Fatal("SetEnabled(false) should disable the view")
+	}
+	if renderer.background.FillColor == color.Transparent {
+		t.Error("Disabled view should show a muted background")
+	}
+	if renderer.placeholder.Color == tv.PlaceholderColor {
+		t.Error("Disabled view should dim its placeholder")
+	}
+
+	test.Type(tv, "hello")
+	if tv.Text != "" {
+		t.Errorf("Disabled view should ignore typing, got '%s'", tv.Text)
+	}
+
+	tv.SetEnabled(true)
+	w.Canvas().Focus(tv)
+	test.Type(tv, "hello")
+	if tv.Text != "hello" {
+		t.Errorf("Enabled view should accept typing, got '%s'", tv.Text)
+	}
+
+	w.Close()
+}
+
+func TestTextView_UndoRedo(t *testing.T) {
+	tv := NewTextView()
+	w := test.NewWindow(tv)
+	w.Resize(fyne.NewSize(200, 100))
+	defer w.Close()
+	w.Canvas().Focus(tv)
+
+	if tv.CanUndo() || tv.CanRedo() {
+		t.Fatal("A new view should have nothing to undo or redo")
+	}
+
+	test.Type(tv, "hello world")
+	if !tv.CanUndo() {
+		t.Fatal("Typing should be undoable")
+	}
+
+	// Typing is undone a word at a time
+	tv.TypedShortcut(&fyne.ShortcutUndo{})
+	if tv.Text != "hello" {
+		t.Errorf("Undo should remove the last word, got %q", tv.Text)
+	}
+	if !tv.CanRedo() {
+		t.Error("Undo should make the edit redoable")
+	}
+	tv.Undo()
+	if tv.Text != "" || tv.CanUndo() {
+		t.Errorf("Undo should remove the first word, got %q", tv.Text)
+	}
+
+	// Shift-Cmd/Ctrl-Z redoes
+	tv.TypedShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyZ, Modifier: fyne.KeyModifierShortcutDefault | fyne.KeyModifierShift})
+	if tv.Text != "hello" {
+		t.Errorf("Shift-Z shortcut should redo, got %q", tv.Text)
+	}
+	tv.Redo()
+	if tv.Text != "hello world" || tv.CanRedo() {
+		t.Errorf("Redo should restore the text, got %q", tv.Text)
+	}
+
+	// A new edit discards the undone edits
+	tv.Undo()
+	test.Type(tv, "!")
+	if tv.CanRedo() {
+		t.Error("Typing should clear the redo stack")
+	}
+
+	// The history is bounded
+	tv.MaximumUndoCount = 2
+	test.Type(tv, " a b c")
+	count := 0
+	for tv.CanUndo() {
+		tv.Undo()
+		count++
+	}
+	if count != 2 {
+		t.Errorf("Undo history should keep MaximumUndoCount edits, undid %d", count)
+	}
+
+	// Text changed behind the history's back drops it rather than panicking
+	test.Type(tv, " more")
+	tv.Entry.Text = "x"
+	tv.Undo()
+	if tv.Text != "x" || tv.CanUndo() || tv.CanRedo() {
+		t.Errorf("Undo should clear a history that no longer matches the text, got %q", tv.Text)
+	}
+}
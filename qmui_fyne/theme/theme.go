@@ -3,12 +3,14 @@
 package theme
 
 import (
-	"fmt"
 	"image/color"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
+	fynetheme "fyne.io/fyne/v2/theme"
 
+	"github.com/paul-hammant/qmui_fyne/animation"
 	"github.com/paul-hammant/qmui_fyne/core"
 )
 
@@ -188,6 +190,23 @@ func newLightTheme(identifier ThemeIdentifier, name string, primary color.RGBA)
 	}
 }
 
+// NewCustomTheme creates a theme with the given brand primary color, based on
+// the light palette or, when dark is true, the dark palette
+func NewCustomTheme(identifier ThemeIdentifier, name string, primary color.RGBA, dark bool) *Theme {
+	if !dark {
+		return newLightTheme(identifier, name, primary)
+	}
+	theme := NewDarkTheme()
+	theme.Identifier = identifier
+	theme.Name = name
+	theme.PrimaryColor = primary
+	theme.AccentColor = primary
+	theme.ButtonBackgroundColor = primary
+	theme.NavBarTintColor = primary
+	theme.TabBarTintColor = primary
+	return theme
+}
+
 // NewGrapefruitTheme creates the Grapefruit theme - coral red (239, 83, 98)
 func NewGrapefruitTheme() *Theme {
 	return newLightTheme(
@@ -278,6 +297,40 @@ type ThemeManager struct {
 	currentTheme   *Theme
 	themes         map[ThemeIdentifier]*Theme
 	listeners      []func(theme *Theme)
+
+	// Custom themes in registration order with their requested positions
+	customThemes  []ThemeIdentifier
+	customIndexes map[ThemeIdentifier]int
+
+	// System appearance following
+	followingSystem   bool
+	listeningSettings bool
+	systemVariant     func() fyne.ThemeVariant
+
+	// Persistence of the selected theme
+	preferences fyne.Preferences
+
+	// Animated theme transitions
+	reducedMotion  bool
+	themeAnimation *animation.Animation
+}
+
+// themePreferenceKey is the preferences key storing the selected theme
+const themePreferenceKey = "qmui.theme.identifier"
+
+// builtInThemeOrder is the QMUI iOS theme order
+var builtInThemeOrder = []ThemeIdentifier{
+	ThemeIdentifierDefault,
+	ThemeIdentifierGrapefruit,
+	ThemeIdentifierBittersweet,
+	ThemeIdentifierSunflower,
+	ThemeIdentifierGrass,
+	ThemeIdentifierMint,
+	ThemeIdentifierKlein,
+	ThemeIdentifierBlueJeans,
+	ThemeIdentifierLavender,
+	ThemeIdentifierPinkRose,
+	ThemeIdentifierDark,
 }
 
 var (
@@ -289,8 +342,9 @@ var (
 func SharedThemeManager() *ThemeManager {
 	managerOnce.Do(func() {
 		sharedManager = &ThemeManager{
-			themes:    make(map[ThemeIdentifier]*Theme),
-			listeners: make([]func(theme *Theme), 0),
+			themes:        make(map[ThemeIdentifier]*Theme),
+			listeners:     make([]func(theme *Theme), 0),
+			customIndexes: make(map[ThemeIdentifier]int),
 		}
 		// Register all 10 QMUI iOS themes + default
 		sharedManager.RegisterTheme(NewDefaultTheme())
@@ -324,20 +378,8 @@ func (tm *ThemeManager) AllThemes() []*Theme {
 	tm.mu.RLock()
 	defer tm.mu.RUnlock()
 
-	// Return in QMUI iOS order
-	order := []ThemeIdentifier{
-		ThemeIdentifierDefault,
-		ThemeIdentifierGrapefruit,
-		ThemeIdentifierBittersweet,
-		ThemeIdentifierSunflower,
-		ThemeIdentifierGrass,
-		ThemeIdentifierMint,
-		ThemeIdentifierKlein,
-		ThemeIdentifierBlueJeans,
-		ThemeIdentifierLavender,
-		ThemeIdentifierPinkRose,
-		ThemeIdentifierDark,
-	}
+	// Return in QMUI iOS order, with custom themes at their positions
+	order := tm.themeOrder()
 
 	themes := make([]*Theme, 0, len(order))
 	for _, id := range order {
@@ -350,19 +392,29 @@ func (tm *ThemeManager) AllThemes() []*Theme {
 
 // AllThemeIdentifiers returns all theme identifiers in QMUI iOS order
 func (tm *ThemeManager) AllThemeIdentifiers() []ThemeIdentifier {
-	return []ThemeIdentifier{
-		ThemeIdentifierDefault,
-		ThemeIdentifierGrapefruit,
-		ThemeIdentifierBittersweet,
-		ThemeIdentifierSunflower,
-		ThemeIdentifierGrass,
-		ThemeIdentifierMint,
-		ThemeIdentifierKlein,
-		ThemeIdentifierBlueJeans,
-		ThemeIdentifierLavender,
-		ThemeIdentifierPinkRose,
-		ThemeIdentifierDark,
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	return tm.themeOrder()
+}
+
+// themeOrder returns the built-in identifiers with custom themes inserted at
+// their requested index, or appended when no index was given.
+// Callers must hold tm.mu.
+func (tm *ThemeManager) themeOrder() []ThemeIdentifier {
+	order := make([]ThemeIdentifier, len(builtInThemeOrder), len(builtInThemeOrder)+len(tm.customThemes))
+	copy(order, builtInThemeOrder)
+
+	for _, id := range tm.customThemes {
+		index := tm.customIndexes[id]
+		if index < 0 || index >= len(order) {
+			order = append(order, id)
+			continue
+		}
+		order = append(order, "")
+		copy(order[index+1:], order[index:])
+		order[index] = id
 	}
+	return order
 }
 
 // CycleTheme switches to the next theme in the list
@@ -385,20 +437,54 @@ func (tm *ThemeManager) CycleTheme() *Theme {
 	return tm.CurrentTheme()
 }
 
-// RegisterTheme registers a theme
+// RegisterTheme registers a theme.
+// Custom themes are listed after the built-in themes.
 func (tm *ThemeManager) RegisterTheme(theme *Theme) {
+	tm.RegisterThemeAt(theme, -1)
+}
+
+// RegisterThemeAt registers a custom theme listed at index in AllThemes.
+// A negative or out of range index lists it after the built-in themes.
+func (tm *ThemeManager) RegisterThemeAt(theme *Theme, index int) {
 	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
 	tm.themes[theme.Identifier] = theme
-	tm.mu.Unlock()
+	if isBuiltInTheme(theme.Identifier) {
+		return
+	}
+	if _, exists := tm.customIndexes[theme.Identifier]; !exists {
+		tm.customThemes = append(tm.customThemes, theme.Identifier)
+	}
+	tm.customIndexes[theme.Identifier] = index
 }
 
 // UnregisterTheme removes a theme
 func (tm *ThemeManager) UnregisterTheme(identifier ThemeIdentifier) {
 	tm.mu.Lock()
 	delete(tm.themes, identifier)
+	if _, exists := tm.customIndexes[identifier]; exists {
+		delete(tm.customIndexes, identifier)
+		for i, id := range tm.customThemes {
+			if id == identifier {
+				tm.customThemes = append(tm.customThemes[:i], tm.customThemes[i+1:]...)
+				break
+			}
+		}
+	}
 	tm.mu.Unlock()
 }
 
+// isBuiltInTheme returns whether the identifier is one of the built-in themes
+func isBuiltInTheme(identifier ThemeIdentifier) bool {
+	for _, id := range builtInThemeOrder {
+		if id == identifier {
+			return true
+		}
+	}
+	return false
+}
+
 // GetTheme returns a theme by identifier
 func (tm *ThemeManager) GetTheme(identifier ThemeIdentifier) *Theme {
 	tm.mu.RLock()
@@ -413,13 +499,169 @@ func (tm *ThemeManager) CurrentTheme() *Theme {
 	return tm.currentTheme
 }
 
-// SetCurrentTheme changes the current theme
+// SetCurrentTheme changes the current theme.
+// Choosing a theme manually stops following the system appearance.
 func (tm *ThemeManager) SetCurrentTheme(identifier ThemeIdentifier) {
+	tm.mu.Lock()
+	tm.followingSystem = false
+	tm.mu.Unlock()
+
+	tm.stopThemeAnimation()
+	if tm.setCurrentTheme(identifier) {
+		tm.persistTheme(identifier)
+	}
+}
+
+// SetCurrentThemeAnimated changes the current theme, cross-fading the
+// primary, background and text colors over the duration. Listeners receive
+// an intermediate Theme snapshot at each animation step.
+func (tm *ThemeManager) SetCurrentThemeAnimated(identifier ThemeIdentifier, duration time.Duration) {
+	tm.mu.Lock()
+	from := tm.currentTheme
+	to, exists := tm.themes[identifier]
+	reduced := tm.reducedMotion || core.ReducedMotion()
+	tm.mu.Unlock()
+
+	if !exists {
+		return
+	}
+	if reduced || duration <= 0 || from == nil || from == to {
+		tm.SetCurrentTheme(identifier)
+		return
+	}
+
+	tm.stopThemeAnimation()
+
+	tm.mu.Lock()
+	tm.followingSystem = false
+	tm.currentTheme = to
+	tm.mu.Unlock()
+	tm.persistTheme(identifier)
+
+	var anim *animation.Animation
+	anim = animation.NewAnimation(duration, animation.EaseInOutQuad, func(progress float64) {
+		snapshot := interpolateTheme(from, to, progress)
+		fyne.Do(func() {
+			if !tm.isCurrentThemeAnimation(anim) {
+				return
+			}
+			tm.applyThemeStep(snapshot)
+		})
+	})
+	anim.OnComplete = func() {
+		fyne.Do(func() {
+			if !tm.isCurrentThemeAnimation(anim) {
+				return
+			}
+			tm.applyThemeStep(to)
+			tm.mu.Lock()
+			tm.themeAnimation = nil
+			tm.mu.Unlock()
+		})
+	}
+
+	tm.mu.Lock()
+	tm.themeAnimation = anim
+	tm.mu.Unlock()
+	anim.Start()
+}
+
+// SetReducedMotion disables animated theme transitions when enabled
+func (tm *ThemeManager) SetReducedMotion(reduced bool) {
+	tm.mu.Lock()
+	tm.reducedMotion = reduced
+	tm.mu.Unlock()
+}
+
+// IsReducedMotion returns whether animated theme transitions are disabled,
+// either on the manager or globally with core.SetReducedMotion
+func (tm *ThemeManager) IsReducedMotion() bool {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	return tm.reducedMotion || core.ReducedMotion()
+}
+
+// stopThemeAnimation cancels any running theme transition
+func (tm *ThemeManager) stopThemeAnimation() {
+	tm.mu.Lock()
+	anim := tm.themeAnimation
+	tm.themeAnimation = nil
+	tm.mu.Unlock()
+
+	if anim != nil {
+		anim.Stop()
+	}
+}
+
+// isCurrentThemeAnimation returns whether anim is the running transition
+func (tm *ThemeManager) isCurrentThemeAnimation(anim *animation.Animation) bool {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	return tm.themeAnimation == anim
+}
+
+// applyThemeStep applies a theme snapshot and notifies listeners
+func (tm *ThemeManager) applyThemeStep(theme *Theme) {
+	tm.mu.RLock()
+	listeners := tm.listeners
+	tm.mu.RUnlock()
+
+	tm.applyThemeToConfiguration(theme)
+	for _, listener := range listeners {
+		listener(theme)
+	}
+}
+
+// interpolateTheme returns a snapshot of to with its key colors blended from
+// the colors of from by progress (0.0 = from, 1.0 = to)
+func interpolateTheme(from, to *Theme, progress float64) *Theme {
+	snapshot := *to
+	snapshot.PrimaryColor = core.BlendColors(from.PrimaryColor, to.PrimaryColor, progress)
+	snapshot.BackgroundColor = core.BlendColors(from.BackgroundColor, to.BackgroundColor, progress)
+	snapshot.TextPrimaryColor = core.BlendColors(from.TextPrimaryColor, to.TextPrimaryColor, progress)
+	return &snapshot
+}
+
+// EnablePersistence remembers the selected theme in the app preferences and
+// restores the stored theme, falling back to the default theme when the
+// stored identifier is no longer registered
+func (tm *ThemeManager) EnablePersistence(app fyne.App) {
+	if app == nil {
+		return
+	}
+	prefs := app.Preferences()
+
+	tm.mu.Lock()
+	tm.preferences = prefs
+	tm.mu.Unlock()
+
+	identifier := ThemeIdentifier(prefs.StringWithFallback(themePreferenceKey, string(ThemeIdentifierDefault)))
+	if tm.GetTheme(identifier) == nil {
+		identifier = ThemeIdentifierDefault
+		prefs.SetString(themePreferenceKey, string(identifier))
+	}
+	tm.setCurrentTheme(identifier)
+}
+
+// persistTheme stores the theme identifier when persistence is enabled
+func (tm *ThemeManager) persistTheme(identifier ThemeIdentifier) {
+	tm.mu.RLock()
+	prefs := tm.preferences
+	tm.mu.RUnlock()
+
+	if prefs != nil {
+		prefs.SetString(themePreferenceKey, string(identifier))
+	}
+}
+
+// setCurrentTheme changes the current theme and notifies listeners,
+// returning false when the identifier is not registered
+func (tm *ThemeManager) setCurrentTheme(identifier ThemeIdentifier) bool {
 	tm.mu.Lock()
 	theme, exists := tm.themes[identifier]
 	if !exists {
 		tm.mu.Unlock()
-		return
+		return false
 	}
 	tm.currentTheme = theme
 	listeners := tm.listeners
@@ -432,41 +674,114 @@ func (tm *ThemeManager) SetCurrentTheme(identifier ThemeIdentifier) {
 	for _, listener := range listeners {
 		listener(theme)
 	}
+	return true
 }
 
-// AddThemeChangeListener adds a listener for theme changes
-func (tm *ThemeManager) AddThemeChangeListener(listener func(theme *Theme)) {
+// FollowSystemAppearance switches between the default and dark themes to
+// match the system light/dark appearance while enabled
+func (tm *ThemeManager) FollowSystemAppearance(follow bool) {
 	tm.mu.Lock()
-	tm.listeners = append(tm.listeners, listener)
+	tm.followingSystem = follow
+	listen := follow && !tm.listeningSettings && fyne.CurrentApp() != nil
+	if listen {
+		tm.listeningSettings = true
+	}
 	tm.mu.Unlock()
+
+	if listen {
+		fyne.CurrentApp().Settings().AddListener(func(fyne.Settings) {
+			tm.applySystemAppearance()
+		})
+	}
+	tm.applySystemAppearance()
 }
 
-// applyThemeToConfiguration applies theme colors to the global configuration
-func (tm *ThemeManager) applyThemeToConfiguration(theme *Theme) {
-	config := core.SharedConfiguration()
+// IsFollowingSystem returns whether the theme follows the system appearance
+func (tm *ThemeManager) IsFollowingSystem() bool {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	return tm.followingSystem
+}
 
-	config.BlueColor = theme.PrimaryColor
-	config.GreenColor = theme.SuccessColor
-	config.RedColor = theme.ErrorColor
-	config.YellowColor = theme.WarningColor
-	config.BackgroundColor = theme.BackgroundColor
-	config.SeparatorColor = theme.SeparatorColor
-	config.PlaceholderColor = theme.InputPlaceholderColor
+// applySystemAppearance switches to the theme matching the system appearance
+func (tm *ThemeManager) applySystemAppearance() {
+	tm.mu.RLock()
+	following := tm.followingSystem
+	variant := tm.systemVariant
+	current := tm.currentTheme
+	tm.mu.RUnlock()
 
-	config.NavBarBackgroundColor = theme.NavBarBackgroundColor
-	config.NavBarTintColor = theme.NavBarTintColor
-	config.NavBarTitleColor = theme.NavBarTitleColor
+	if !following {
+		return
+	}
+	if variant == nil {
+		if fyne.CurrentApp() == nil {
+			return
+		}
+		variant = fyne.CurrentApp().Settings().ThemeVariant
+	}
 
-	config.TabBarBackgroundColor = theme.TabBarBackgroundColor
-	config.TabBarItemTitleColorSelected = theme.TabBarTintColor
-	config.TabBarItemImageColorSelected = theme.TabBarTintColor
+	identifier := ThemeIdentifierDefault
+	if variant() == fynetheme.VariantDark {
+		identifier = ThemeIdentifierDark
+	}
+	if current == nil || current.Identifier != identifier {
+		tm.setCurrentTheme(identifier)
+	}
+}
 
-	config.TableViewCellBackgroundColor = theme.TableCellBackgroundColor
-	config.TableViewCellSelectedBackgroundColor = theme.TableCellSelectedColor
-	config.TableViewCellTitleLabelColor = theme.TextPrimaryColor
-	config.TableViewCellDetailLabelColor = theme.TextSecondaryColor
+// AddThemeChangeListener adds a listener for theme changes
+func (tm *ThemeManager) AddThemeChangeListener(listener func(theme *Theme)) {
+	tm.mu.Lock()
+	tm.listeners = append(tm.listeners, listener)
+	tm.mu.Unlock()
+}
 
-	config.ButtonTintColor = theme.ButtonBackgroundColor
+// applyThemeToConfiguration applies theme colors to the global configuration
+func (tm *ThemeManager) applyThemeToConfiguration(theme *Theme) {
+	core.SharedConfiguration().Set(func(config *core.Configuration) {
+		config.BlueColor = theme.PrimaryColor
+		config.GreenColor = theme.SuccessColor
+		config.RedColor = theme.ErrorColor
+		config.YellowColor = theme.WarningColor
+		config.BackgroundColor = theme.BackgroundColor
+		config.SeparatorColor = theme.SeparatorColor
+		config.PlaceholderColor = theme.InputPlaceholderColor
+
+		config.NavBarBackgroundColor = theme.NavBarBackgroundColor
+		config.NavBarTintColor = theme.NavBarTintColor
+		config.NavBarTitleColor = theme.NavBarTitleColor
+
+		config.TabBarBackgroundColor = theme.TabBarBackgroundColor
+		config.TabBarItemTitleColorSelected = theme.TabBarTintColor
+		config.TabBarItemImageColorSelected = theme.TabBarTintColor
+
+		config.TableViewCellBackgroundColor = theme.TableCellBackgroundColor
+		config.TableViewCellSelectedBackgroundColor = theme.TableCellSelectedColor
+		config.TableViewCellTitleLabelColor = theme.TextPrimaryColor
+		config.TableViewCellDetailLabelColor = theme.TextSecondaryColor
+
+		config.ButtonTintColor = theme.ButtonBackgroundColor
+		config.FocusRingColor = theme.PrimaryColor
+
+		config.AlertSeparatorColor = theme.SeparatorColor
+		config.AlertTitleColor = theme.TextPrimaryColor
+		config.AlertMessageColor = theme.TextPrimaryColor
+		config.AlertHeaderBackgroundColor = theme.SurfaceColor
+		config.AlertButtonBackgroundColor = theme.SurfaceColor
+		config.AlertButtonHighlightBackgroundColor = theme.TableCellSelectedColor
+		config.AlertTextFieldTextColor = theme.InputTextColor
+		config.AlertTextFieldBorderColor = theme.InputBorderColor
+
+		config.SheetSeparatorColor = theme.SeparatorColor
+		config.SheetHeaderBackgroundColor = theme.SurfaceColor
+		config.SheetButtonBackgroundColor = theme.SurfaceColor
+		config.SheetButtonHighlightBackgroundColor = theme.TableCellSelectedColor
+
+		// Toasts contrast with the page: dark on light themes, light on dark
+		config.ToastBackgroundColor = core.ColorWithAlpha(theme.TextPrimaryColor, 0.75)
+		config.ToastTextColor = theme.BackgroundColor
+	})
 }
 
 // ThemeColor creates a color that adapts to theme changes
@@ -513,14 +828,54 @@ func ApplyThemeToWindow(window fyne.Window) {
 	applyThemeToObject(window.Content(), theme)
 }
 
+var (
+	themeOverridesMu sync.RWMutex
+	themeOverrides   = make(map[fyne.CanvasObject]*Theme)
+)
+
+// SetThemeOverride makes ApplyThemeToWindow apply t to obj and its children
+// instead of the current theme, until a nested override is encountered. A nil
+// t removes the override. Overrides are kept until removed, holding on to
+// obj, so callers must remove them when obj is discarded.
+func SetThemeOverride(obj fyne.CanvasObject, t *Theme) {
+	if obj == nil {
+		return
+	}
+	if t == nil {
+		ClearThemeOverride(obj)
+		return
+	}
+	themeOverridesMu.Lock()
+	themeOverrides[obj] = t
+	themeOverridesMu.Unlock()
+}
+
+// ClearThemeOverride removes the theme override from obj
+func ClearThemeOverride(obj fyne.CanvasObject) {
+	themeOverridesMu.Lock()
+	delete(themeOverrides, obj)
+	themeOverridesMu.Unlock()
+}
+
+// ThemeOverride returns the theme override set on obj, or nil
+func ThemeOverride(obj fyne.CanvasObject) *Theme {
+	themeOverridesMu.RLock()
+	defer themeOverridesMu.RUnlock()
+	return themeOverrides[obj]
+}
+
 func applyThemeToObject(obj fyne.CanvasObject, theme *Theme) {
 	if obj == nil {
 		return
 	}
 
+	// An override replaces the inherited theme for this subtree
+	if override := ThemeOverride(obj); override != nil {
+		theme = override
+	}
+
 	// Apply theme if object implements Themeable
 	if themeable, ok := obj.(Themeable); ok {
-		fmt.Printf("Applying theme to: %T\n", obj)
 		themeable.ApplyTheme(theme)
 	}
 
@@ -1,10 +1,31 @@
 package theme
 
 import (
+	"image/color"
 	"sync"
 	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	fynetheme "fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/paul-hammant/qmui_fyne/core"
 )
 
+// waitFor polls cond until it holds or a second has passed, returning
+// whether it held
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for !cond() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return cond()
+}
+
 func TestThemeManager_HotSwitch(t *testing.T) {
 	// Reset the shared manager for testing
 	managerOnce = sync.Once{}
@@ -171,3 +192,268 @@ func TestThemeManager_InvalidTheme(t *testing.T) {
 		t.Error("Theme should not change for invalid identifier")
 	}
 }
+
+func TestThemeManager_FollowSystemAppearance(t *testing.T) {
+	ResetForTesting()
+	defer ResetForTesting()
+
+	tm := SharedThemeManager()
+	variant := fynetheme.VariantDark
+	tm.systemVariant = func() fyne.ThemeVariant {
+		return variant
+	}
+
+	tm.FollowSystemAppearance(true)
+	if !tm.IsFollowingSystem() {
+		t.Fatal("IsFollowingSystem should be true")
+	}
+	if tm.CurrentTheme().Identifier != ThemeIdentifierDark {
+		t.Errorf("Dark system appearance should select the dark theme, got %s", tm.CurrentTheme().Identifier)
+	}
+
+	variant = fynetheme.VariantLight
+	tm.applySystemAppearance()
+	if tm.CurrentTheme().Identifier != ThemeIdentifierDefault {
+		t.Errorf("Light system appearance should select the default theme, got %s", tm.CurrentTheme().Identifier)
+	}
+
+	tm.SetCurrentTheme(ThemeIdentifierMint)
+	if tm.IsFollowingSystem() {
+		t.Error("SetCurrentTheme should stop following the system appearance")
+	}
+
+	variant = fynetheme.VariantDark
+	tm.applySystemAppearance()
+	if tm.CurrentTheme().Identifier != ThemeIdentifierMint {
+		t.Error("Theme should not change once following is off")
+	}
+}
+
+func TestThemeManager_NotifiesConfigurationListeners(t *testing.T) {
+	ResetForTesting()
+	core.ResetConfigurationForTesting()
+	defer ResetForTesting()
+	defer core.ResetConfigurationForTesting()
+
+	tm := SharedThemeManager()
+	var notified color.Color
+	core.SharedConfiguration().AddChangeListener(func(config *core.Configuration) {
+		notified = config.BlueColor
+	})
+
+	tm.SetCurrentTheme(ThemeIdentifierMint)
+	if notified != tm.CurrentTheme().PrimaryColor {
+		t.Error("Changing the theme should notify configuration listeners with the new colors")
+	}
+}
+
+func TestThemeManager_EnablePersistence(t *testing.T) {
+	ResetForTesting()
+	defer ResetForTesting()
+
+	app := test.NewApp()
+	defer app.Quit()
+
+	tm := SharedThemeManager()
+	tm.EnablePersistence(app)
+	tm.SetCurrentTheme(ThemeIdentifierKlein)
+
+	if got := app.Preferences().String(themePreferenceKey); got != string(ThemeIdentifierKlein) {
+		t.Errorf("Stored theme = %q, want %q", got, ThemeIdentifierKlein)
+	}
+
+	// Simulate a relaunch
+	ResetForTesting()
+	tm = SharedThemeManager()
+	tm.EnablePersistence(app)
+	if tm.CurrentTheme().Identifier != ThemeIdentifierKlein {
+		t.Errorf("Restored theme = %s, want %s", tm.CurrentTheme().Identifier, ThemeIdentifierKlein)
+	}
+
+	// A stored theme that no longer exists falls back to default
+	app.Preferences().SetString(themePreferenceKey, "removed-custom-theme")
+	ResetForTesting()
+	tm = SharedThemeManager()
+	tm.EnablePersistence(app)
+	if tm.CurrentTheme().Identifier != ThemeIdentifierDefault {
+		t.Errorf("Missing stored theme should fall back to default, got %s", tm.CurrentTheme().Identifier)
+	}
+}
+
+func TestThemeManager_SetCurrentThemeAnimated(t *testing.T) {
+	ResetForTesting()
+	defer ResetForTesting()
+
+	app := test.NewApp()
+	defer app.Quit()
+
+	tm := SharedThemeManager()
+	var mu sync.Mutex
+	var steps []*Theme
+	tm.AddThemeChangeListener(func(theme *Theme) {
+		mu.Lock()
+		steps = append(steps, theme)
+		mu.Unlock()
+	})
+
+	tm.SetCurrentThemeAnimated(ThemeIdentifierGrapefruit, 100*time.Millisecond)
+	if tm.CurrentTheme().Identifier != ThemeIdentifierGrapefruit {
+		t.Errorf("CurrentTheme should report the target theme, got %s", tm.CurrentTheme().Identifier)
+	}
+	target := tm.GetTheme(ThemeIdentifierGrapefruit)
+	waitFor(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(steps) > 0 && steps[len(steps)-1] == target
+	})
+
+	mu.Lock()
+	count := len(steps)
+	last := steps[len(steps)-1]
+	mu.Unlock()
+	if count < 2 {
+		t.Errorf("Listeners should be notified at each step, got %d calls", count)
+	}
+	if last != target {
+		t.Error("Final step should deliver the registered target theme")
+	}
+
+	mu.Lock()
+	steps = nil
+	mu.Unlock()
+	tm.SetReducedMotion(true)
+	tm.SetCurrentThemeAnimated(ThemeIdentifierMint, 100*time.Millisecond)
+	mu.Lock()
+	count = len(steps)
+	mu.Unlock()
+	if count != 1 || tm.CurrentTheme().Identifier != ThemeIdentifierMint {
+		t.Errorf("Reduced motion should switch immediately, got %d calls", count)
+	}
+}
+
+func TestInterpolateTheme(t *testing.T) {
+	from := NewDefaultTheme()
+	to := NewDarkTheme()
+
+	mid := interpolateTheme(from, to, 0.5)
+	if mid.Identifier != to.Identifier {
+		t.Error("Snapshot should carry the target identifier")
+	}
+	want := core.BlendColors(from.BackgroundColor, to.BackgroundColor, 0.5)
+	if mid.BackgroundColor != want {
+		t.Errorf("BackgroundColor = %v, want %v", mid.BackgroundColor, want)
+	}
+	if to.BackgroundColor == mid.BackgroundColor {
+		t.Error("Interpolation should not modify the target theme")
+	}
+}
+
+func TestNewCustomTheme(t *testing.T) {
+	brand := color.RGBA{R: 12, G: 34, B: 56, A: 255}
+
+	light := NewCustomTheme("brand", "Brand", brand, false)
+	if light.PrimaryColor != brand || light.NavBarTintColor != brand || light.IsDarkMode {
+		t.Error("Light custom theme should use the brand color on the light palette")
+	}
+
+	dark := NewCustomTheme("brand-dark", "Brand Dark", brand, true)
+	if dark.PrimaryColor != brand || dark.TabBarTintColor != brand || !dark.IsDarkMode {
+		t.Error("Dark custom theme should use the brand color on the dark palette")
+	}
+	if dark.Identifier != "brand-dark" || dark.Name != "Brand Dark" {
+		t.Error("Custom theme should keep its identifier and name")
+	}
+}
+
+func TestThemeManager_CustomThemeOrder(t *testing.T) {
+	ResetForTesting()
+	defer ResetForTesting()
+
+	tm := SharedThemeManager()
+	brand := color.RGBA{R: 12, G: 34, B: 56, A: 255}
+	tm.RegisterTheme(NewCustomTheme("brand", "Brand", brand, false))
+	tm.RegisterThemeAt(NewCustomTheme("first", "First", brand, false), 0)
+
+	ids := tm.AllThemeIdentifiers()
+	if len(ids) != 13 {
+		t.Fatalf("Expected 13 identifiers, got %d", len(ids))
+	}
+	if ids[0] != "first" {
+		t.Errorf("Theme registered at index 0 should be first, got %s", ids[0])
+	}
+	if ids[len(ids)-1] != "brand" {
+		t.Errorf("Theme registered without index should be last, got %s", ids[len(ids)-1])
+	}
+
+	themes := tm.AllThemes()
+	if len(themes) != 13 || themes[0].Identifier != "first" {
+		t.Error("AllThemes should follow the same order")
+	}
+
+	tm.UnregisterTheme("first")
+	if ids := tm.AllThemeIdentifiers(); len(ids) != 12 || ids[0] != ThemeIdentifierDefault {
+		t.Error("Unregistered custom theme should be removed from the order")
+	}
+}
+
+type themeRecorder struct {
+	widget.BaseWidget
+	applied *Theme
+}
+
+func newThemeRecorder() *themeRecorder {
+	r := &themeRecorder{}
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+func (r *themeRecorder) ApplyTheme(theme *Theme) {
+	r.applied = theme
+}
+
+func (r *themeRecorder) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+func TestThemeOverride(t *testing.T) {
+	ResetForTesting()
+	defer ResetForTesting()
+
+	tm := SharedThemeManager()
+	banner := tm.GetTheme(ThemeIdentifierGrapefruit)
+	nested := tm.GetTheme(ThemeIdentifierDark)
+
+	outside := newThemeRecorder()
+	inBanner := newThemeRecorder()
+	inNested := newThemeRecorder()
+	nestedBox := container.NewVBox(inNested)
+	bannerBox := container.NewVBox(inBanner, nestedBox)
+	content := container.NewVBox(outside, bannerBox)
+
+	SetThemeOverride(bannerBox, banner)
+	SetThemeOverride(nestedBox, nested)
+	defer ClearThemeOverride(bannerBox)
+	defer ClearThemeOverride(nestedBox)
+
+	applyThemeToObject(content, tm.CurrentTheme())
+	if outside.applied != tm.CurrentTheme() {
+		t.Error("Objects outside the override should use the current theme")
+	}
+	if inBanner.applied != banner {
+		t.Error("Objects inside the override should use the override theme")
+	}
+	if inNested.applied != nested {
+		t.Error("A nested override should take precedence over the outer override")
+	}
+
+	ClearThemeOverride(bannerBox)
+	applyThemeToObject(content, tm.CurrentTheme())
+	if inBanner.applied != tm.CurrentTheme() {
+		t.Error("Cleared override should fall back to the current theme")
+	}
+
+	SetThemeOverride(nestedBox, nil)
+	if ThemeOverride(nestedBox) != nil {
+		t.Error("Setting a nil override should remove it")
+	}
+}
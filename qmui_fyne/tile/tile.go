@@ -11,6 +11,7 @@ import (
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/paul-hammant/qmui_fyne/badge"
 	"github.com/paul-hammant/qmui_fyne/core"
 )
 
@@ -32,12 +33,17 @@ type ComponentTile struct {
 	TitleFontSize   float32
 	IconSize        fyne.Size
 	Padding         float32
+	SelectionColor  color.Color
+
+	// State
+	Selected bool
 
 	// Callbacks
 	OnTapped func()
 
-	mu      sync.RWMutex
-	hovered bool
+	mu        sync.RWMutex
+	hovered   bool
+	badgeText string
 }
 
 // NewComponentTile creates a new styled component tile
@@ -54,6 +60,7 @@ func NewComponentTile(title string, icon fyne.CanvasObject) *ComponentTile {
 		TitleFontSize:   11,
 		IconSize:        fyne.NewSize(48, 48),
 		Padding:         12,
+		SelectionColor:  cfg.BlueColor,
 	}
 	t.ExtendBaseWidget(t)
 	return t
@@ -66,6 +73,29 @@ func NewComponentTileWithDrawing(title string, drawFunc func(size fyne.Size, tin
 	return NewComponentTile(title, icon)
 }
 
+// SetBadge shows a badge in the top-right corner; an empty string hides it
+func (t *ComponentTile) SetBadge(text string) {
+	t.mu.Lock()
+	t.badgeText = text
+	t.mu.Unlock()
+	t.Refresh()
+}
+
+// Badge returns the badge text
+func (t *ComponentTile) Badge() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.badgeText
+}
+
+// SetSelected sets whether the tile is highlighted as selected
+func (t *ComponentTile) SetSelected(selected bool) {
+	t.mu.Lock()
+	t.Selected = selected
+	t.mu.Unlock()
+	t.Refresh()
+}
+
 // CreateRenderer implements fyne.Widget
 func (t *ComponentTile) CreateRenderer() fyne.WidgetRenderer {
 	t.ExtendBaseWidget(t)
@@ -79,11 +109,16 @@ func (t *ComponentTile) CreateRenderer() fyne.WidgetRenderer {
 	title.TextSize = t.TitleFontSize
 	title.Alignment = fyne.TextAlignCenter
 
-	return &tileRenderer{
+	badgeView := badge.NewBadge(t.Badge())
+
+	r := &tileRenderer{
 		tile:       t,
 		background: background,
 		title:      title,
+		badge:      badgeView,
 	}
+	r.Refresh()
+	return r
 }
 
 func (t *ComponentTile) Tapped(_ *fyne.PointEvent) {
@@ -118,6 +153,7 @@ type tileRenderer struct {
 	tile       *ComponentTile
 	background *canvas.Rectangle
 	title      *canvas.Text
+	badge      *badge.Badge
 }
 
 func (r *tileRenderer) Destroy() {}
@@ -141,6 +177,11 @@ func (r *tileRenderer) Layout(size fyne.Size) {
 	titleY := size.Height - titleSize.Height - padding
 	r.title.Resize(fyne.NewSize(size.Width, titleSize.Height))
 	r.title.Move(fyne.NewPos(0, titleY))
+
+	// Badge in the top-right corner
+	badgeSize := r.badge.MinSize()
+	r.badge.Resize(badgeSize)
+	r.badge.Move(fyne.NewPos(size.Width-badgeSize.Width-4, 4))
 }
 
 func (r *tileRenderer) MinSize() fyne.Size {
@@ -161,6 +202,8 @@ func (r *tileRenderer) MinSize() fyne.Size {
 func (r *tileRenderer) Refresh() {
 	r.tile.mu.RLock()
 	hovered := r.tile.hovered
+	selected := r.tile.Selected
+	badgeText := r.tile.badgeText
 	r.tile.mu.RUnlock()
 
 	r.background.FillColor = r.tile.BackgroundColor
@@ -172,6 +215,18 @@ func (r *tileRenderer) Refresh() {
 		// Subtle highlight on hover
 		r.background.FillColor = color.RGBA{R: 245, G: 250, B: 255, A: 255}
 	}
+	if selected {
+		r.background.StrokeColor = r.tile.SelectionColor
+		r.background.StrokeWidth = r.tile.BorderWidth * 2
+		r.background.FillColor = core.BlendColors(r.background.FillColor, r.tile.SelectionColor, 0.08)
+	}
+
+	if badgeText != "" {
+		r.badge.SetText(badgeText)
+		r.badge.Show()
+	} else {
+		r.badge.Hide()
+	}
 
 	r.title.Text = r.tile.Title
 	r.title.Color = r.tile.TitleColor
@@ -189,7 +244,7 @@ func (r *tileRenderer) Objects() []fyne.CanvasObject {
 	if r.tile.Icon != nil {
 		objects = append(objects, r.tile.Icon)
 	}
-	objects = append(objects, r.title)
+	objects = append(objects, r.title, r.badge)
 	return objects
 }
 
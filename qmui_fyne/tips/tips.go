@@ -10,9 +10,13 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
+	"github.com/paul-hammant/qmui_fyne/progress"
+	"github.com/paul-hammant/qmui_fyne/toast"
 )
 
 // HUDStyle defines the style of tip to show
@@ -24,15 +28,32 @@ const (
 	HUDStyleSuccess
 	HUDStyleError
 	HUDStyleInfo
+	HUDStyleProgress
 )
 
-// HUD provides a convenient API for showing toast-like notifications with icons
+// HUD provides a convenient API for showing toast-like notifications with icons.
+// Tips shown while another is visible are queued and displayed in order.
+// Loading and progress tips that need a manual dismiss stay up until
+// HideCurrent or HideLoading, and the queue advances after that.
 type HUD struct {
-	window    fyne.Window
-	popup     *widget.PopUp
-	mu        sync.RWMutex
-	isVisible bool
-	timer     *time.Timer
+	// MinimumDisplayDuration is how long a tip stays up before a queued tip replaces it
+	MinimumDisplayDuration time.Duration
+
+	window        fyne.Window
+	options       toast.Options
+	colorDefaults core.ColorDefaults
+	popup         *widget.PopUp
+	mu            sync.RWMutex
+	isVisible     bool
+	timer         *time.Timer
+
+	// Queue of tips waiting for the current one to hide
+	queue        []queuedTip
+	currentStyle HUDStyle
+	shownAt      time.Time
+
+	// Ring shown by the current progress tip
+	progressRing *progress.RingProgress
 
 	// Animation state for loading spinner
 	spinnerAngle float64
@@ -40,16 +61,65 @@ type HUD struct {
 	stopSpinner  chan struct{}
 }
 
+// queuedTip is a tip waiting to be displayed
+type queuedTip struct {
+	style    HUDStyle
+	text     string
+	duration float64
+	ring     *progress.RingProgress // For progress tips
+	onCancel func()                 // For cancelable loading tips
+}
+
+const defaultMinimumDisplayDuration = 500 * time.Millisecond
+
 // NewHUD creates a new HUD instance for a window
 func NewHUD(window fyne.Window) *HUD {
-	return &HUD{window: window}
+	return NewHUDWithOptions(window, toast.DefaultOptions())
+}
+
+// NewHUDWithOptions creates a HUD for a window whose tips are styled by
+// options instead of the shared Configuration
+func NewHUDWithOptions(window fyne.Window, options toast.Options) *HUD {
+	t := &HUD{window: window, options: options, MinimumDisplayDuration: defaultMinimumDisplayDuration}
+	t.applyColorDefaults()
+	return t
 }
 
-// showTip displays a tip with the given style and text
+// applyColorDefaults follows the configuration's toast color unless the
+// options customized it
+func (t *HUD) applyColorDefaults() {
+	t.colorDefaults.Apply(core.ColorDefault{Field: &t.options.BackgroundColor, Value: core.SharedConfiguration().ToastBackgroundColor})
+}
+
+// showTip displays a tip with the given style and text, queueing it behind
+// the current tip if one is visible
 func (t *HUD) showTip(style HUDStyle, text string, duration float64) {
-	t.HideCurrent()
+	t.enqueueTip(queuedTip{style: style, text: text, duration: duration})
+}
+
+// enqueueTip displays tip now, or queues it if another tip is visible
+func (t *HUD) enqueueTip(tip queuedTip) {
+	t.mu.Lock()
+	if t.isVisible {
+		t.queue = append(t.queue, tip)
+		t.mu.Unlock()
+		return
+	}
+	t.mu.Unlock()
+
+	t.displayTip(tip)
+}
+
+// displayTip builds and shows the popup for a tip
+func (t *HUD) displayTip(tip queuedTip) {
+	t.mu.Lock()
+	t.hidePopupLocked()
+	t.mu.Unlock()
+
+	style, text, duration := tip.style, tip.text, tip.duration
 
 	config := core.SharedConfiguration()
+	t.applyColorDefaults()
 
 	// Build content based on style
 	var objects []fyne.CanvasObject
@@ -67,19 +137,40 @@ func (t *HUD) showTip(style HUDStyle, text string, duration float64) {
 	case HUDStyleInfo:
 		icon := t.createInfoIcon()
 		objects = append(objects, icon)
+	case HUDStyleProgress:
+		t.mu.Lock()
+		t.progressRing = tip.ring
+		t.mu.Unlock()
+		objects = append(objects, container.NewCenter(tip.ring))
 	}
 
 	// Add text label
 	if text != "" {
-		label := canvas.NewText(text, config.ToastTextColor)
-		label.TextSize = config.ToastFontSize
-		label.Alignment = fyne.TextAlignCenter
-		objects = append(objects, label)
+		lines := []string{text}
+		if t.options.MaxWidth > 0 {
+			lines = core.WrapText(text, config.ToastFontSize, fyne.TextStyle{}, t.options.MaxWidth-theme.Padding()*2)
+		}
+		for _, line := range lines {
+			label := canvas.NewText(line, config.ToastTextColor)
+			label.TextSize = config.ToastFontSize
+			label.Alignment = fyne.TextAlignCenter
+			objects = append(objects, label)
+		}
+	}
+
+	// The Cancel control, and Escape, back out of a cancelable tip
+	var popup *widget.PopUp
+	cancel := func() bool {
+		return t.cancelTip(popup, tip.onCancel)
+	}
+	if tip.onCancel != nil {
+		control := newCancelControl(config.ToastTextColor, func() { cancel() })
+		objects = append(objects, container.NewCenter(control))
 	}
 
 	// Background
-	background := canvas.NewRectangle(config.ToastBackgroundColor)
-	background.CornerRadius = config.ToastCornerRadius
+	background := canvas.NewRectangle(t.options.BackgroundColor)
+	background.CornerRadius = t.options.CornerRadius
 
 	content := container.NewVBox(objects...)
 	padded := container.NewPadded(content)
@@ -87,8 +178,11 @@ func (t *HUD) showTip(style HUDStyle, text string, duration float64) {
 	popupContent := container.NewStack(background, padded)
 
 	t.mu.Lock()
-	t.popup = widget.NewPopUp(popupContent, t.window.Canvas())
+	popup = widget.NewPopUp(popupContent, t.window.Canvas())
+	t.popup = popup
 	t.isVisible = true
+	t.currentStyle = style
+	t.shownAt = time.Now()
 	t.mu.Unlock()
 
 	// Position the popup at center
@@ -100,14 +194,19 @@ func (t *HUD) showTip(style HUDStyle, text string, duration float64) {
 		(canvasSize.Height-contentSize.Height)/2,
 	)
 
-	t.popup.Move(pos)
-	t.popup.Show()
+	popup.Move(pos)
+	core.SharedOverlayManager().Show(t.window.Canvas(), popup, core.SharedConfiguration().WindowLevelQMUIToast)
+	if tip.onCancel != nil {
+		core.SharedOverlayManager().SetDismissHandler(popup, cancel)
+	} else {
+		core.SharedOverlayManager().SetPassive(popup, true)
+	}
 
-	// Set up auto-hide timer (except for loading which requires manual dismiss)
-	if duration > 0 && style != HUDStyleLoading {
+	// Set up auto-hide timer (except for loading and progress which require manual dismiss)
+	if duration > 0 && style != HUDStyleLoading && style != HUDStyleProgress {
 		t.mu.Lock()
 		t.timer = time.AfterFunc(time.Duration(duration*float64(time.Second)), func() {
-			t.HideCurrent()
+			fyne.Do(t.HideCurrent)
 		})
 		t.mu.Unlock()
 	}
@@ -115,7 +214,7 @@ func (t *HUD) showTip(style HUDStyle, text string, duration float64) {
 
 // createLoadingSpinner creates an animated loading spinner
 func (t *HUD) createLoadingSpinner() fyne.CanvasObject {
-	spinner := &loadingSpinner{tips: t}
+	spinner := &loadingSpinner{tips: t, tint: core.SharedConfiguration().ToastTextColor}
 	spinner.ExtendBaseWidget(spinner)
 
 	// Start animation
@@ -149,23 +248,37 @@ func (t *HUD) animateSpinner(spinner *loadingSpinner) {
 	}
 }
 
+// createProgressRing creates the ring for a progress tip
+func (t *HUD) createProgressRing() *progress.RingProgress {
+	tint := core.SharedConfiguration().ToastTextColor
+	ring := progress.NewRingProgress()
+	ring.TintColor = tint
+	ring.TrackColor = core.ColorWithAlpha(tint, 80.0/255)
+	ring.LineWidth = 3
+	ring.ViewSize = fyne.NewSize(40, 40)
+	ring.ShowsText = true
+	ring.LabelColor = tint
+	ring.LabelFontSize = 11
+	return ring
+}
+
 // createSuccessIcon creates a checkmark icon
 func (t *HUD) createSuccessIcon() fyne.CanvasObject {
-	icon := &successIcon{}
+	icon := &successIcon{tint: core.SharedConfiguration().ToastTextColor}
 	icon.ExtendBaseWidget(icon)
 	return icon
 }
 
 // createErrorIcon creates an X icon
 func (t *HUD) createErrorIcon() fyne.CanvasObject {
-	icon := &errorIcon{}
+	icon := &errorIcon{tint: core.SharedConfiguration().ToastTextColor}
 	icon.ExtendBaseWidget(icon)
 	return icon
 }
 
 // createInfoIcon creates an info icon
 func (t *HUD) createInfoIcon() fyne.CanvasObject {
-	icon := &infoIcon{}
+	icon := &infoIcon{tint: core.SharedConfiguration().ToastTextColor}
 	icon.ExtendBaseWidget(icon)
 	return icon
 }
@@ -185,6 +298,13 @@ func (t *HUD) ShowLoading(text string) {
 	t.showTip(HUDStyleLoading, text, 0)
 }
 
+// ShowCancelableLoading shows a loading tip with a Cancel control (manual
+// dismiss required). Tapping Cancel, or pressing Escape, hides the tip and
+// calls onCancel.
+func (t *HUD) ShowCancelableLoading(text string, onCancel func()) {
+	t.enqueueTip(queuedTip{style: HUDStyleLoading, text: text, onCancel: onCancel})
+}
+
 // ShowLoadingWithDuration shows a loading tip that auto-hides
 func (t *HUD) ShowLoadingWithDuration(text string, duration float64) {
 	t.showTip(HUDStyleLoading, text, duration)
@@ -220,22 +340,148 @@ func (t *HUD) ShowInfoWithDuration(text string, duration float64) {
 	t.showTip(HUDStyleInfo, text, duration)
 }
 
-// HideLoading hides the loading tip
+// ProgressHandle updates a progress tip shown with ShowProgress
+type ProgressHandle struct {
+	hud  *HUD
+	ring *progress.RingProgress
+}
+
+// SetProgress sets the progress shown in the tip (0.0 - 1.0)
+func (h *ProgressHandle) SetProgress(value float64) {
+	h.ring.SetProgress(value)
+}
+
+// Progress returns the progress shown in the tip
+func (h *ProgressHandle) Progress() float64 {
+	return h.ring.Progress
+}
+
+// Hide hides the progress tip if it is showing, or removes it from the queue
+func (h *ProgressHandle) Hide() {
+	h.hud.mu.Lock()
+	if h.hud.progressRing != h.ring {
+		for i, tip := range h.hud.queue {
+			if tip.ring == h.ring {
+				h.hud.queue = append(h.hud.queue[:i], h.hud.queue[i+1:]...)
+				break
+			}
+		}
+		h.hud.mu.Unlock()
+		return
+	}
+	h.hud.mu.Unlock()
+
+	h.hud.HideCurrent()
+}
+
+// ShowProgress shows a determinate progress tip (manual dismiss required)
+func (t *HUD) ShowProgress(text string) *ProgressHandle {
+	ring := t.createProgressRing()
+	t.enqueueTip(queuedTip{style: HUDStyleProgress, text: text, ring: ring})
+	return &ProgressHandle{hud: t, ring: ring}
+}
+
+// HideLoading hides the loading tip. If the loading tip is still queued
+// behind another tip, it is removed from the queue instead.
 func (t *HUD) HideLoading() {
+	t.mu.Lock()
+	if t.isVisible && t.currentStyle != HUDStyleLoading {
+		for i, tip := range t.queue {
+			if tip.style == HUDStyleLoading {
+				t.queue = append(t.queue[:i], t.queue[i+1:]...)
+				break
+			}
+		}
+		t.mu.Unlock()
+		return
+	}
+	t.mu.Unlock()
+
 	t.HideCurrent()
 }
 
-// HideCurrent hides the currently showing tip
+// HideCurrent hides the currently showing tip and shows the next queued tip.
+// When tips are queued, the current one stays up for at least MinimumDisplayDuration.
 func (t *HUD) HideCurrent() {
+	t.mu.Lock()
+	if t.isVisible && len(t.queue) > 0 {
+		if remaining := t.MinimumDisplayDuration - time.Since(t.shownAt); remaining > 0 {
+			if t.timer != nil {
+				t.timer.Stop()
+			}
+			t.timer = time.AfterFunc(remaining, func() {
+				fyne.Do(t.HideCurrent)
+			})
+			t.mu.Unlock()
+			return
+		}
+	}
+
+	t.hidePopupLocked()
+	next := t.dequeueLocked()
+	t.mu.Unlock()
+
+	if next != nil {
+		t.displayTip(*next)
+	}
+}
+
+// cancelTip hides popup at once, if it is still the current tip, shows the
+// next queued tip and calls onCancel. It returns whether popup was hidden.
+func (t *HUD) cancelTip(popup *widget.PopUp, onCancel func()) bool {
+	t.mu.Lock()
+	if popup == nil || t.popup != popup {
+		t.mu.Unlock()
+		return false
+	}
+	t.hidePopupLocked()
+	next := t.dequeueLocked()
+	t.mu.Unlock()
+
+	if next != nil {
+		t.displayTip(*next)
+	}
+	if onCancel != nil {
+		onCancel()
+	}
+	return true
+}
+
+// dequeueLocked removes and returns the next queued tip, or nil if there is
+// none; t.mu must be held
+func (t *HUD) dequeueLocked() *queuedTip {
+	if len(t.queue) == 0 {
+		return nil
+	}
+	next := t.queue[0]
+	t.queue = t.queue[1:]
+	return &next
+}
+
+// ClearQueue discards tips waiting to be shown
+func (t *HUD) ClearQueue() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	t.queue = nil
+}
 
+// PendingCount returns the number of tips waiting to be shown
+func (t *HUD) PendingCount() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.queue)
+}
+
+// hidePopupLocked hides the current popup; t.mu must be held
+func (t *HUD) hidePopupLocked() {
 	// Stop spinner animation
 	if t.spinnerAnim && t.stopSpinner != nil {
 		close(t.stopSpinner)
 		t.spinnerAnim = false
 	}
 
+	t.progressRing = nil
+
 	// Stop timer
 	if t.timer != nil {
 		t.timer.Stop()
@@ -244,7 +490,7 @@ func (t *HUD) HideCurrent() {
 
 	// Hide popup
 	if t.popup != nil && t.isVisible {
-		t.popup.Hide()
+		core.SharedOverlayManager().Hide(t.popup.Canvas, t.popup)
 		t.popup = nil
 		t.isVisible = false
 	}
@@ -257,10 +503,81 @@ func (t *HUD) IsVisible() bool {
 	return t.isVisible
 }
 
+// cancelControl is the Cancel pill shown by a cancelable loading tip
+type cancelControl struct {
+	widget.BaseWidget
+
+	tint     color.Color
+	onTapped func()
+}
+
+func newCancelControl(tint color.Color, onTapped func()) *cancelControl {
+	c := &cancelControl{tint: tint, onTapped: onTapped}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// Tapped handles tap events
+func (c *cancelControl) Tapped(_ *fyne.PointEvent) {
+	if c.onTapped != nil {
+		c.onTapped()
+	}
+}
+
+// Cursor returns the cursor for this widget
+func (c *cancelControl) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (c *cancelControl) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+
+	background := canvas.NewRectangle(core.ColorWithAlpha(c.tint, 0.15))
+	text := canvas.NewText("Cancel", c.tint)
+	text.TextSize = core.SharedConfiguration().ToastFontSize
+	text.TextStyle = fyne.TextStyle{Bold: true}
+	text.Alignment = fyne.TextAlignCenter
+
+	return &cancelControlRenderer{control: c, background: background, text: text}
+}
+
+type cancelControlRenderer struct {
+	control    *cancelControl
+	background *canvas.Rectangle
+	text       *canvas.Text
+}
+
+func (r *cancelControlRenderer) Destroy() {}
+
+func (r *cancelControlRenderer) Layout(size fyne.Size) {
+	r.background.Resize(size)
+	r.background.CornerRadius = size.Height / 2
+	textSize := r.text.MinSize()
+	r.text.Resize(fyne.NewSize(size.Width, textSize.Height))
+	r.text.Move(fyne.NewPos(0, (size.Height-textSize.Height)/2))
+}
+
+func (r *cancelControlRenderer) MinSize() fyne.Size {
+	textSize := r.text.MinSize()
+	return fyne.NewSize(textSize.Width+32, textSize.Height+12)
+}
+
+func (r *cancelControlRenderer) Refresh() {
+	r.background.FillColor = core.ColorWithAlpha(r.control.tint, 0.15)
+	r.text.Color = r.control.tint
+	r.background.Refresh()
+	r.text.Refresh()
+}
+
+func (r *cancelControlRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.background, r.text}
+}
+
 // loadingSpinner widget
 type loadingSpinner struct {
 	widget.BaseWidget
 	tips *HUD
+	tint color.Color
 }
 
 func (s *loadingSpinner) CreateRenderer() fyne.WidgetRenderer {
@@ -285,7 +602,6 @@ func (r *loadingSpinnerRenderer) MinSize() fyne.Size {
 
 func (r *loadingSpinnerRenderer) buildObjects(size fyne.Size) {
 	r.objects = nil
-	config := core.SharedConfiguration()
 
 	centerX := size.Width / 2
 	centerY := size.Height / 2
@@ -306,7 +622,7 @@ func (r *loadingSpinnerRenderer) buildObjects(size fyne.Size) {
 			opacity = 50
 		}
 
-		lineColor := color.RGBA{R: 255, G: 255, B: 255, A: opacity}
+		lineColor := core.ColorWithAlpha(r.spinner.tint, float64(opacity)/255)
 
 		x1 := centerX + float32(math.Cos(lineAngle)*float64(radius-6))
 		y1 := centerY + float32(math.Sin(lineAngle)*float64(radius-6))
@@ -320,8 +636,6 @@ func (r *loadingSpinnerRenderer) buildObjects(size fyne.Size) {
 
 		r.objects = append(r.objects, line)
 	}
-
-	_ = config // avoid unused warning
 }
 
 func (r *loadingSpinnerRenderer) Refresh() {
@@ -335,6 +649,7 @@ func (r *loadingSpinnerRenderer) Objects() []fyne.CanvasObject {
 // successIcon widget - circle with checkmark inside (iOS QMUI style)
 type successIcon struct {
 	widget.BaseWidget
+	tint color.Color
 }
 
 func (s *successIcon) CreateRenderer() fyne.WidgetRenderer {
@@ -343,13 +658,13 @@ func (s *successIcon) CreateRenderer() fyne.WidgetRenderer {
 	// Circle outline
 	circle := canvas.NewCircle(color.Transparent)
 	circle.StrokeWidth = 2
-	circle.StrokeColor = color.White
+	circle.StrokeColor = s.tint
 
 	// Checkmark inside circle
-	line1 := canvas.NewLine(color.White)
+	line1 := canvas.NewLine(s.tint)
 	line1.StrokeWidth = 2.5
 
-	line2 := canvas.NewLine(color.White)
+	line2 := canvas.NewLine(s.tint)
 	line2.StrokeWidth = 2.5
 
 	return &successIconRenderer{
@@ -416,6 +731,7 @@ func (r *successIconRenderer) Objects() []fyne.CanvasObject {
 // errorIcon widget - circle with X inside (iOS QMUI style)
 type errorIcon struct {
 	widget.BaseWidget
+	tint color.Color
 }
 
 func (s *errorIcon) CreateRenderer() fyne.WidgetRenderer {
@@ -424,12 +740,12 @@ func (s *errorIcon) CreateRenderer() fyne.WidgetRenderer {
 	// Circle outline
 	circle := canvas.NewCircle(color.Transparent)
 	circle.StrokeWidth = 2
-	circle.StrokeColor = color.White
+	circle.StrokeColor = s.tint
 
-	line1 := canvas.NewLine(color.White)
+	line1 := canvas.NewLine(s.tint)
 	line1.StrokeWidth = 2.5
 
-	line2 := canvas.NewLine(color.White)
+	line2 := canvas.NewLine(s.tint)
 	line2.StrokeWidth = 2.5
 
 	return &errorIconRenderer{
@@ -483,6 +799,7 @@ func (r *errorIconRenderer) Objects() []fyne.CanvasObject {
 // infoIcon widget - i in circle
 type infoIcon struct {
 	widget.BaseWidget
+	tint color.Color
 }
 
 func (s *infoIcon) CreateRenderer() fyne.WidgetRenderer {
@@ -490,11 +807,11 @@ func (s *infoIcon) CreateRenderer() fyne.WidgetRenderer {
 
 	circle := canvas.NewCircle(color.Transparent)
 	circle.StrokeWidth = 2
-	circle.StrokeColor = color.White
+	circle.StrokeColor = s.tint
 
-	dot := canvas.NewCircle(color.White)
+	dot := canvas.NewCircle(s.tint)
 
-	line := canvas.NewLine(color.White)
+	line := canvas.NewLine(s.tint)
 	line.StrokeWidth = 2
 
 	return &infoIconRenderer{
@@ -571,6 +888,16 @@ func ShowLoading(window fyne.Window, text string) {
 	getHUDForWindow(window).ShowLoading(text)
 }
 
+// ShowCancelableLoading shows a loading tip with a Cancel control
+func ShowCancelableLoading(window fyne.Window, text string, onCancel func()) {
+	getHUDForWindow(window).ShowCancelableLoading(text, onCancel)
+}
+
+// ShowProgress shows a progress tip
+func ShowProgress(window fyne.Window, text string) *ProgressHandle {
+	return getHUDForWindow(window).ShowProgress(text)
+}
+
 // ShowSuccess shows a success tip
 func ShowSuccess(window fyne.Window, text string) {
 	getHUDForWindow(window).ShowSuccess(text)
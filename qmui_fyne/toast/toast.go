@@ -10,9 +10,13 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/paul-hammant/qmui_fyne/core"
+	qmuitheme "github.com/paul-hammant/qmui_fyne/theme"
 )
 
 // ToastPosition defines where the toast appears
@@ -27,6 +31,85 @@ const (
 	ToastPositionBottom
 )
 
+var (
+	defaultPositionMu  sync.RWMutex
+	defaultPosition    = ToastPositionCenter
+	defaultBottomInset float32
+)
+
+// SetDefaultPosition sets the position used by new toasts and ShowMessage
+func SetDefaultPosition(position ToastPosition) {
+	defaultPositionMu.Lock()
+	defer defaultPositionMu.Unlock()
+	defaultPosition = position
+}
+
+// DefaultPosition returns the position used by new toasts and ShowMessage
+func DefaultPosition() ToastPosition {
+	defaultPositionMu.RLock()
+	defer defaultPositionMu.RUnlock()
+	return defaultPosition
+}
+
+// SetDefaultBottomInset sets the space new toasts keep clear at the bottom
+// of the window, so bottom toasts sit above a custom bottom bar. A
+// navigation.TabBar is kept clear of without it; see RegisterBottomBar.
+func SetDefaultBottomInset(inset float32) {
+	defaultPositionMu.Lock()
+	defer defaultPositionMu.Unlock()
+	defaultBottomInset = inset
+}
+
+// DefaultBottomInset returns the bottom inset used by new toasts
+func DefaultBottomInset() float32 {
+	defaultPositionMu.RLock()
+	defer defaultPositionMu.RUnlock()
+	return defaultBottomInset
+}
+
+var (
+	// bottomBarsMu guards bottomBars, the bars along the bottom of a canvas
+	// that bottom toasts keep clear of
+	bottomBarsMu sync.RWMutex
+	bottomBars   = make(map[fyne.CanvasObject]fyne.Canvas)
+)
+
+// RegisterBottomBar keeps bottom toasts shown in c above bar while it is
+// visible. navigation.TabBar registers itself once it is laid out in a
+// window, so toasts don't cover it.
+func RegisterBottomBar(c fyne.Canvas, bar fyne.CanvasObject) {
+	bottomBarsMu.Lock()
+	defer bottomBarsMu.Unlock()
+	bottomBars[bar] = c
+}
+
+// UnregisterBottomBar stops keeping bottom toasts above bar
+func UnregisterBottomBar(bar fyne.CanvasObject) {
+	bottomBarsMu.Lock()
+	defer bottomBarsMu.Unlock()
+	delete(bottomBars, bar)
+}
+
+// bottomBarInset returns how much of the bottom of c the visible
+// registered bars cover
+func bottomBarInset(c fyne.Canvas) float32 {
+	bottomBarsMu.RLock()
+	defer bottomBarsMu.RUnlock()
+
+	var inset float32
+	height := c.Size().Height
+	for bar, barCanvas := range bottomBars {
+		if barCanvas != c || !bar.Visible() {
+			continue
+		}
+		top := fyne.CurrentApp().Driver().AbsolutePositionForObject(bar).Y
+		if top > 0 && top < height {
+			inset = fyne.Max(inset, height-top)
+		}
+	}
+	return inset
+}
+
 // ToastContentView defines custom content for toast
 type ToastContentView interface {
 	fyne.CanvasObject
@@ -63,20 +146,27 @@ type ToastBackgroundView struct {
 	CornerRadius    float32
 	BackgroundColor color.Color
 	BlurEnabled     bool
+
+	colorDefaults core.ColorDefaults
 }
 
 // NewToastBackgroundView creates a new toast background
 func NewToastBackgroundView() *ToastBackgroundView {
 	config := core.SharedConfiguration()
 	bg := &ToastBackgroundView{
-		CornerRadius:    config.ToastCornerRadius,
-		BackgroundColor: config.ToastBackgroundColor,
-		BlurEnabled:     false,
+		CornerRadius: config.ToastCornerRadius,
+		BlurEnabled:  false,
 	}
+	bg.applyColorDefaults()
 	bg.ExtendBaseWidget(bg)
 	return bg
 }
 
+// applyColorDefaults follows the configuration's toast color unless it was customized
+func (bg *ToastBackgroundView) applyColorDefaults() {
+	bg.colorDefaults.Apply(core.ColorDefault{Field: &bg.BackgroundColor, Value: core.SharedConfiguration().ToastBackgroundColor})
+}
+
 func (bg *ToastBackgroundView) CreateRenderer() fyne.WidgetRenderer {
 	rect := canvas.NewRectangle(bg.BackgroundColor)
 	rect.CornerRadius = bg.CornerRadius
@@ -95,6 +185,7 @@ func (r *toastBackgroundRenderer) Destroy()              {}
 func (r *toastBackgroundRenderer) Layout(size fyne.Size) { r.rect.Resize(size) }
 func (r *toastBackgroundRenderer) MinSize() fyne.Size    { return fyne.NewSize(0, 0) }
 func (r *toastBackgroundRenderer) Refresh() {
+	r.bg.applyColorDefaults()
 	r.rect.FillColor = r.bg.BackgroundColor
 	r.rect.CornerRadius = r.bg.CornerRadius
 	r.rect.Refresh()
@@ -110,6 +201,11 @@ type ToastView struct {
 	DetailText string
 	Icon       fyne.Resource
 
+	// Action shows a trailing tappable label, snackbar style
+	ActionTitle string
+	ActionColor color.Color // Defaults to the current theme's primary color
+	OnAction    func()
+
 	// Styling
 	DisplayPosition   ToastPosition
 	ContentInsets     core.EdgeInsets
@@ -120,6 +216,8 @@ type ToastView struct {
 	TextSize          float32
 	DetailTextSize    float32
 	MarginFromScreen  float32
+	BottomInset       float32 // Kept clear below bottom toasts, beyond any registered tab bar
+	MaxWidth          float32 // Wraps the text to fit, 0 means no limit
 	IconSize          fyne.Size
 	SpacingBetweenIconAndText float32
 	SpacingBetweenTextAndDetail float32
@@ -138,26 +236,25 @@ type ToastView struct {
 	OnHide func()
 
 	// State
-	mu       sync.RWMutex
-	visible  bool
-	timer    *time.Timer
-	popup    *widget.PopUp
-	window   fyne.Window
+	mu            sync.RWMutex
+	visible       bool
+	timer         *time.Timer
+	popup         *widget.PopUp
+	window        fyne.Window
+	colorDefaults core.ColorDefaults
 }
 
 // NewToastView creates a new toast view
 func NewToastView() *ToastView {
 	config := core.SharedConfiguration()
 	tv := &ToastView{
-		DisplayPosition:   ToastPositionCenter,
+		DisplayPosition:   DefaultPosition(),
 		ContentInsets:     config.ToastContentInsets,
 		CornerRadius:      config.ToastCornerRadius,
-		BackgroundColor:   config.ToastBackgroundColor,
-		TextColor:         config.ToastTextColor,
-		DetailTextColor:   config.ToastTextColor,
 		TextSize:          config.ToastFontSize,
 		DetailTextSize:    config.ToastFontSize - 2,
 		MarginFromScreen:  config.ToastMarginFromScreen,
+		BottomInset:       DefaultBottomInset(),
 		IconSize:          fyne.NewSize(32, 32),
 		SpacingBetweenIconAndText: 8,
 		SpacingBetweenTextAndDetail: 4,
@@ -167,10 +264,22 @@ func NewToastView() *ToastView {
 		MaskUserInteraction: false,
 		Animator:          &DefaultToastAnimator{},
 	}
+	tv.applyColorDefaults()
 	tv.ExtendBaseWidget(tv)
 	return tv
 }
 
+// applyColorDefaults sets the colors that weren't customized from the
+// configuration, so a toast shown after a theme switch uses the new theme
+func (tv *ToastView) applyColorDefaults() {
+	config := core.SharedConfiguration()
+	tv.colorDefaults.Apply(
+		core.ColorDefault{Field: &tv.BackgroundColor, Value: config.ToastBackgroundColor},
+		core.ColorDefault{Field: &tv.TextColor, Value: config.ToastTextColor},
+		core.ColorDefault{Field: &tv.DetailTextColor, Value: config.ToastTextColor},
+	)
+}
+
 // NewToastViewWithText creates a toast with text
 func NewToastViewWithText(text string) *ToastView {
 	tv := NewToastView()
@@ -178,6 +287,37 @@ func NewToastViewWithText(text string) *ToastView {
 	return tv
 }
 
+// Options overrides the styling of a single toast without changing the
+// shared Configuration. Start from DefaultOptions and change the fields
+// needed.
+type Options struct {
+	CornerRadius    float32
+	MaxWidth        float32 // 0 means no limit
+	BottomInset     float32 // Kept clear below bottom toasts
+	BackgroundColor color.Color
+}
+
+// DefaultOptions returns the toast styling from the shared Configuration
+func DefaultOptions() Options {
+	config := core.SharedConfiguration()
+	return Options{
+		CornerRadius:    config.ToastCornerRadius,
+		BottomInset:     DefaultBottomInset(),
+		BackgroundColor: config.ToastBackgroundColor,
+	}
+}
+
+// ApplyOptions sets the toast's styling from options. A nil
+// BackgroundColor keeps the current color.
+func (tv *ToastView) ApplyOptions(options Options) {
+	tv.CornerRadius = options.CornerRadius
+	tv.MaxWidth = options.MaxWidth
+	tv.BottomInset = options.BottomInset
+	if options.BackgroundColor != nil {
+		tv.BackgroundColor = options.BackgroundColor
+	}
+}
+
 // Show displays the toast in the given window
 func (tv *ToastView) ShowIn(window fyne.Window) {
 	tv.mu.Lock()
@@ -189,6 +329,7 @@ func (tv *ToastView) ShowIn(window fyne.Window) {
 	tv.window = window
 	tv.mu.Unlock()
 
+	tv.applyColorDefaults()
 	content := tv.buildContent()
 	tv.popup = widget.NewPopUp(content, window.Canvas())
 
@@ -204,9 +345,11 @@ func (tv *ToastView) ShowIn(window fyne.Window) {
 			tv.MarginFromScreen,
 		)
 	case ToastPositionBottom:
+		// Sit above a registered tab bar rather than covering it
+		inset := fyne.Max(tv.BottomInset, bottomBarInset(window.Canvas()))
 		pos = fyne.NewPos(
 			(canvasSize.Width-contentSize.Width)/2,
-			canvasSize.Height-contentSize.Height-tv.MarginFromScreen,
+			canvasSize.Height-inset-contentSize.Height-tv.MarginFromScreen,
 		)
 	default: // Center
 		pos = fyne.NewPos(
@@ -216,7 +359,8 @@ func (tv *ToastView) ShowIn(window fyne.Window) {
 	}
 
 	tv.popup.Move(pos)
-	tv.popup.Show()
+	core.SharedOverlayManager().Show(window.Canvas(), tv.popup, core.SharedConfiguration().WindowLevelQMUIToast)
+	core.SharedOverlayManager().SetPassive(tv.popup, true)
 
 	if tv.Animator != nil {
 		tv.Animator.ShowAnimation(tv, nil)
@@ -254,7 +398,7 @@ func (tv *ToastView) Hide() {
 	hideFunc := func() {
 		tv.mu.Lock()
 		if tv.popup != nil {
-			tv.popup.Hide()
+			core.SharedOverlayManager().Hide(tv.popup.Canvas, tv.popup)
 			tv.popup = nil
 		}
 		tv.visible = false
@@ -290,20 +434,28 @@ func (tv *ToastView) buildContent() fyne.CanvasObject {
 		objects = append(objects, icon)
 	}
 
-	// Text
-	if tv.Text != "" {
+	// Text, with the action alongside it
+	if tv.Text != "" && tv.ActionTitle != "" {
 		text := canvas.NewText(tv.Text, tv.TextColor)
 		text.TextSize = tv.TextSize
-		text.Alignment = fyne.TextAlignCenter
-		objects = append(objects, text)
+		objects = append(objects, container.NewHBox(text, layout.NewSpacer(), newToastActionButton(tv)))
+	} else if tv.Text != "" {
+		for _, line := range tv.wrap(tv.Text, tv.TextSize) {
+			text := canvas.NewText(line, tv.TextColor)
+			text.TextSize = tv.TextSize
+			text.Alignment = fyne.TextAlignCenter
+			objects = append(objects, text)
+		}
 	}
 
 	// Detail text
 	if tv.DetailText != "" {
-		detail := canvas.NewText(tv.DetailText, tv.DetailTextColor)
-		detail.TextSize = tv.DetailTextSize
-		detail.Alignment = fyne.TextAlignCenter
-		objects = append(objects, detail)
+		for _, line := range tv.wrap(tv.DetailText, tv.DetailTextSize) {
+			detail := canvas.NewText(line, tv.DetailTextColor)
+			detail.TextSize = tv.DetailTextSize
+			detail.Alignment = fyne.TextAlignCenter
+			objects = append(objects, detail)
+		}
 	}
 
 	// Background
@@ -316,6 +468,51 @@ func (tv *ToastView) buildContent() fyne.CanvasObject {
 	return container.NewStack(background, padded)
 }
 
+// wrap breaks text into lines that keep the toast within MaxWidth
+func (tv *ToastView) wrap(text string, textSize float32) []string {
+	if tv.MaxWidth <= 0 {
+		return []string{text}
+	}
+	width := tv.MaxWidth - theme.Padding()*2
+	return core.WrapText(text, textSize, fyne.TextStyle{}, width)
+}
+
+// toastActionButton is the tappable action label of a snackbar-style toast
+type toastActionButton struct {
+	widget.BaseWidget
+	toast *ToastView
+}
+
+func newToastActionButton(tv *ToastView) *toastActionButton {
+	b := &toastActionButton{toast: tv}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// Tapped fires the action and dismisses the toast
+func (b *toastActionButton) Tapped(_ *fyne.PointEvent) {
+	if b.toast.OnAction != nil {
+		b.toast.OnAction()
+	}
+	b.toast.Hide()
+}
+
+// Cursor returns the cursor for this widget
+func (b *toastActionButton) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (b *toastActionButton) CreateRenderer() fyne.WidgetRenderer {
+	actionColor := b.toast.ActionColor
+	if actionColor == nil {
+		actionColor = qmuitheme.SharedThemeManager().CurrentTheme().PrimaryColor
+	}
+	label := canvas.NewText(b.toast.ActionTitle, actionColor)
+	label.TextSize = b.toast.TextSize
+	label.TextStyle = fyne.TextStyle{Bold: true}
+	return widget.NewSimpleRenderer(container.NewPadded(label))
+}
+
 func (tv *ToastView) CreateRenderer() fyne.WidgetRenderer {
 	tv.ExtendBaseWidget(tv)
 	return &toastRenderer{toast: tv}
@@ -331,66 +528,147 @@ func (r *toastRenderer) MinSize() fyne.Size            { return fyne.NewSize(0,
 func (r *toastRenderer) Refresh()                      {}
 func (r *toastRenderer) Objects() []fyne.CanvasObject { return nil }
 
-// Tips is a higher-level toast API similar to QMUITips
+// Tips is a higher-level toast API similar to QMUITips.
+// Text toasts are queued so each stays up for its full duration.
 type Tips struct {
+	// MaxQueueLength caps the toasts waiting to be shown; the oldest is dropped when exceeded
+	MaxQueueLength int
+
 	window fyne.Window
 	toast  *ToastView
+	queue  []*ToastView
+	mu     sync.Mutex
 }
 
+const defaultMaxQueueLength = 5
+
 // NewTips creates a new Tips instance for a window
 func NewTips(window fyne.Window) *Tips {
-	return &Tips{window: window}
+	return &Tips{window: window, MaxQueueLength: defaultMaxQueueLength}
+}
+
+// enqueue shows tv now, or after the toasts already showing or waiting
+func (t *Tips) enqueue(tv *ToastView) {
+	t.mu.Lock()
+	if t.toast != nil && t.toast.IsVisible() {
+		t.queue = append(t.queue, tv)
+		if t.MaxQueueLength > 0 && len(t.queue) > t.MaxQueueLength {
+			t.queue = t.queue[len(t.queue)-t.MaxQueueLength:]
+		}
+		t.mu.Unlock()
+		return
+	}
+	t.mu.Unlock()
+
+	t.present(tv)
+}
+
+// replaceCurrent shows tv immediately in place of the current toast
+func (t *Tips) replaceCurrent(tv *ToastView) {
+	t.mu.Lock()
+	current := t.toast
+	t.toast = nil
+	t.mu.Unlock()
+
+	if current != nil {
+		current.Hide()
+	}
+	t.present(tv)
+}
+
+// present shows tv and moves on to the next queued toast once it hides
+func (t *Tips) present(tv *ToastView) {
+	onHide := tv.OnHide
+	tv.OnHide = func() {
+		if onHide != nil {
+			onHide()
+		}
+		t.showNext(tv)
+	}
+
+	t.mu.Lock()
+	t.toast = tv
+	t.mu.Unlock()
+
+	tv.ShowIn(t.window)
+}
+
+// showNext shows the next queued toast after hidden has finished
+func (t *Tips) showNext(hidden *ToastView) {
+	t.mu.Lock()
+	if t.toast != hidden {
+		// hidden was replaced rather than finishing
+		t.mu.Unlock()
+		return
+	}
+	t.toast = nil
+	if len(t.queue) == 0 {
+		t.mu.Unlock()
+		return
+	}
+	next := t.queue[0]
+	t.queue = t.queue[1:]
+	t.mu.Unlock()
+
+	t.present(next)
 }
 
 // ShowText shows a simple text toast
 func (t *Tips) ShowText(text string) {
-	t.HideCurrent()
-	t.toast = NewToastViewWithText(text)
-	t.toast.ShowIn(t.window)
+	t.enqueue(NewToastViewWithText(text))
+}
+
+// ShowTextAt shows a simple text toast at the given position
+func (t *Tips) ShowTextAt(text string, position ToastPosition) {
+	tv := NewToastViewWithText(text)
+	tv.DisplayPosition = position
+	t.enqueue(tv)
+}
+
+// ShowTextWithOptions shows a simple text toast styled by options
+func (t *Tips) ShowTextWithOptions(text string, options Options) {
+	tv := NewToastViewWithText(text)
+	tv.ApplyOptions(options)
+	t.enqueue(tv)
 }
 
 // ShowTextWithDuration shows a toast for a specific duration
 func (t *Tips) ShowTextWithDuration(text string, duration float64) {
-	t.HideCurrent()
-	t.toast = NewToastViewWithText(text)
-	t.toast.Duration = duration
-	t.toast.ShowIn(t.window)
+	tv := NewToastViewWithText(text)
+	tv.Duration = duration
+	t.enqueue(tv)
 }
 
 // ShowSuccess shows a success toast
 func (t *Tips) ShowSuccess(text string) {
-	t.HideCurrent()
-	t.toast = NewToastView()
-	t.toast.Text = text
+	tv := NewToastView()
+	tv.Text = text
 	// You would set a success icon here
-	t.toast.ShowIn(t.window)
+	t.replaceCurrent(tv)
 }
 
 // ShowError shows an error toast
 func (t *Tips) ShowError(text string) {
-	t.HideCurrent()
-	t.toast = NewToastView()
-	t.toast.Text = text
-	t.toast.BackgroundColor = color.RGBA{R: 180, G: 50, B: 50, A: 230}
-	t.toast.ShowIn(t.window)
+	tv := NewToastView()
+	tv.Text = text
+	tv.BackgroundColor = color.RGBA{R: 180, G: 50, B: 50, A: 230}
+	t.replaceCurrent(tv)
 }
 
 // ShowInfo shows an info toast
 func (t *Tips) ShowInfo(text string) {
-	t.HideCurrent()
-	t.toast = NewToastView()
-	t.toast.Text = text
-	t.toast.ShowIn(t.window)
+	tv := NewToastView()
+	tv.Text = text
+	t.replaceCurrent(tv)
 }
 
 // ShowLoading shows a loading toast (manual dismiss required)
 func (t *Tips) ShowLoading(text string) {
-	t.HideCurrent()
-	t.toast = NewToastView()
-	t.toast.Text = text
-	t.toast.Duration = 0 // Manual dismiss
+	tv := NewToastView()
+	tv.Text = text
+	tv.Duration = 0 // Manual dismiss
 	// You would add a loading indicator here
-	t.toast.ShowIn(t.window)
+	t.replaceCurrent(tv)
 }
 
 // HideLoading hides the loading toast
@@ -398,14 +676,33 @@ func (t *Tips) HideLoading() {
 	t.HideCurrent()
 }
 
-// HideCurrent hides any currently showing toast
+// HideCurrent hides any currently showing toast, moving on to the next queued one
 func (t *Tips) HideCurrent() {
-	if t.toast != nil && t.toast.IsVisible() {
-		t.toast.Hide()
-		t.toast = nil
+	t.mu.Lock()
+	current := t.toast
+	t.mu.Unlock()
+
+	if current != nil && current.IsVisible() {
+		current.Hide()
 	}
 }
 
+// ClearAll discards queued toasts and hides the current one
+func (t *Tips) ClearAll() {
+	t.mu.Lock()
+	t.queue = nil
+	t.mu.Unlock()
+
+	t.HideCurrent()
+}
+
+// PendingCount returns the number of toasts waiting to be shown
+func (t *Tips) PendingCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.queue)
+}
+
 // Global Tips functions
 
 var (
@@ -461,7 +758,37 @@ func Hide(window fyne.Window) {
 	getTipsForWindow(window).HideCurrent()
 }
 
-// ShowMessage is an alias for ShowText
+// ShowMessage shows a text toast at the default position
 func ShowMessage(window fyne.Window, text string) {
-	ShowText(window, text)
+	ShowMessageAt(window, text, DefaultPosition())
+}
+
+// ShowMessageWithOptions shows a text toast at the default position, styled
+// by options instead of the shared Configuration
+func ShowMessageWithOptions(window fyne.Window, text string, options Options) {
+	getTipsForWindow(window).ShowTextWithOptions(text, options)
+}
+
+// ShowMessageWithDuration shows a text toast for a duration in seconds
+func ShowMessageWithDuration(window fyne.Window, text string, duration float64) {
+	ShowTextWithDuration(window, text, duration)
+}
+
+// ClearAll discards queued toasts and hides the current one in the window
+func ClearAll(window fyne.Window) {
+	getTipsForWindow(window).ClearAll()
+}
+
+// ShowActionMessage shows a toast with a trailing action, snackbar style.
+// Tapping the action calls onAction and dismisses the toast.
+func ShowActionMessage(window fyne.Window, text, actionTitle string, onAction func()) {
+	tv := NewToastViewWithText(text)
+	tv.ActionTitle = actionTitle
+	tv.OnAction = onAction
+	getTipsForWindow(window).enqueue(tv)
+}
+
+// ShowMessageAt shows a text toast at the given position
+func ShowMessageAt(window fyne.Window, text string, position ToastPosition) {
+	getTipsForWindow(window).ShowTextAt(text, position)
 }